@@ -0,0 +1,38 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package bsoncodec
+
+import (
+	"reflect"
+
+	"go.mongodb.org/mongo-driver/bson/bsonoptions"
+	"go.mongodb.org/mongo-driver/bson/bsonrw"
+)
+
+// ArrayCodec is the Codec used for Go array values (e.g. [3]float64). Unlike the default array decoder, it
+// can be configured to decode BSON arrays whose length does not match the length of the Go array.
+type ArrayCodec struct {
+	DecodeAllowLengthMismatch bool
+}
+
+var _ ValueCodec = &ArrayCodec{}
+
+// NewArrayCodec returns an ArrayCodec with options opts.
+func NewArrayCodec(opts ...*bsonoptions.ArrayCodecOptions) *ArrayCodec {
+	arrayOpt := bsonoptions.MergeArrayCodecOptions(opts...)
+	return &ArrayCodec{*arrayOpt.DecodeAllowLengthMismatch}
+}
+
+// EncodeValue is the ValueEncoder for array types.
+func (ac *ArrayCodec) EncodeValue(ec EncodeContext, vw bsonrw.ValueWriter, val reflect.Value) error {
+	return DefaultValueEncoders{}.ArrayEncodeValue(ec, vw, val)
+}
+
+// DecodeValue is the ValueDecoder for array types.
+func (ac *ArrayCodec) DecodeValue(dc DecodeContext, vr bsonrw.ValueReader, val reflect.Value) error {
+	return DefaultValueDecoders{}.decodeArray(dc, vr, val, ac.DecodeAllowLengthMismatch)
+}
@@ -0,0 +1,67 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package bsoncodec
+
+import (
+	"reflect"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson/bsonoptions"
+	"go.mongodb.org/mongo-driver/bson/bsonrw"
+	"go.mongodb.org/mongo-driver/internal/testutil/assert"
+	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
+)
+
+func TestArrayCodec(t *testing.T) {
+	// arrayValueReader returns a ValueReader positioned at a BSON array containing the given doubles.
+	arrayValueReader := func(t *testing.T, values ...float64) bsonrw.ValueReader {
+		t.Helper()
+
+		idx, arr := bsoncore.AppendArrayStart(nil)
+		for i, v := range values {
+			arr = bsoncore.AppendDoubleElement(arr, string(rune('0'+i)), v)
+		}
+		arr, _ = bsoncore.AppendArrayEnd(arr, idx)
+		doc := bsoncore.BuildDocumentFromElements(nil, bsoncore.AppendArrayElement(nil, "a", arr))
+
+		dr, err := bsonrw.NewBSONDocumentReader(doc).ReadDocument()
+		assert.Nil(t, err, "ReadDocument error: %v", err)
+		_, vr, err := dr.ReadElement()
+		assert.Nil(t, err, "ReadElement error: %v", err)
+		return vr
+	}
+
+	testCases := []struct {
+		name     string
+		opts     *bsonoptions.ArrayCodecOptions
+		values   []float64
+		expected [3]float64
+		errored  bool
+	}{
+		{"exact length", nil, []float64{1, 2, 3}, [3]float64{1, 2, 3}, false},
+		{"shorter errors by default", nil, []float64{1, 2}, [3]float64{}, true},
+		{"longer errors by default", nil, []float64{1, 2, 3, 4}, [3]float64{}, true},
+		{"shorter zero-filled", bsonoptions.ArrayCodec().SetDecodeAllowLengthMismatch(true), []float64{1, 2},
+			[3]float64{1, 2, 0}, false},
+		{"longer truncated", bsonoptions.ArrayCodec().SetDecodeAllowLengthMismatch(true), []float64{1, 2, 3, 4},
+			[3]float64{1, 2, 3}, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Start with non-zero values to ensure elements missing from the BSON array are reset.
+			actual := [3]float64{9, 9, 9}
+			dc := DecodeContext{Registry: buildDefaultRegistry()}
+			err := NewArrayCodec(tc.opts).DecodeValue(dc, arrayValueReader(t, tc.values...), reflect.ValueOf(&actual).Elem())
+			if tc.errored {
+				assert.NotNil(t, err, "expected DecodeValue error, got nil")
+				return
+			}
+			assert.Nil(t, err, "DecodeValue error: %v", err)
+			assert.Equal(t, tc.expected, actual, "expected array %v, got %v", tc.expected, actual)
+		})
+	}
+}
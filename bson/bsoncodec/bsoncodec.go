@@ -113,16 +113,40 @@ type EncodeContext struct {
 	MinSize bool
 }
 
+// TruncationPolicy determines how a BSON double that is not a whole number is decoded into a Go integer type.
+type TruncationPolicy int
+
+// These constants are the supported truncation policies.
+const (
+	// TruncationError causes decoding to fail if the double is not a whole number. This is the default.
+	TruncationError TruncationPolicy = iota
+
+	// TruncationTruncate discards the fractional part of the double (e.g. 3.7 is decoded as 3 and -3.7 as -3). This
+	// is equivalent to setting Truncate or using the "truncate" struct tag.
+	TruncationTruncate
+
+	// TruncationRound rounds the double to the nearest integer, rounding half away from zero (e.g. 3.5 is decoded as 4
+	// and -3.5 as -4).
+	TruncationRound
+)
+
 // DecodeContext is the contextual information required for a Codec to decode a
 // value.
 type DecodeContext struct {
 	*Registry
 	Truncate bool
+	// TruncationPolicy determines how a BSON double that is not a whole number is decoded into an integer type. If
+	// Truncate is true, the double is truncated regardless of this setting.
+	TruncationPolicy TruncationPolicy
 	// Ancestor is the type of a containing document. This is mainly used to determine what type
 	// should be used when decoding an embedded document into an empty interface. For example, if
 	// Ancestor is a bson.M, BSON embedded document values being decoded into an empty interface
 	// will be decoded into a bson.M.
 	Ancestor reflect.Type
+	// DefaultDocumentDecoder, if set, is consulted when decoding a document into an empty interface. If the document's
+	// discriminator field has a registered type, the document is decoded into a value of that type. Otherwise, the
+	// document is decoded as it would be without a DefaultDocumentDecoder (e.g. into a primitive.D by default).
+	DefaultDocumentDecoder *DiscriminatorRegistry
 }
 
 // ValueCodec is the interface that groups the methods to encode and decode
@@ -248,6 +248,27 @@ func (dvd DefaultValueDecoders) BooleanDecodeValue(dctx DecodeContext, vr bsonrw
 	return nil
 }
 
+// doubleToInteger returns f64 with its fractional part removed according to the truncation settings in dc. An error is
+// returned if f64 is not a whole number and the settings do not allow a lossy conversion.
+func doubleToInteger(dc DecodeContext, f64 float64) (float64, error) {
+	if math.Floor(f64) == f64 {
+		return f64, nil
+	}
+	if dc.Truncate {
+		return math.Trunc(f64), nil
+	}
+
+	switch dc.TruncationPolicy {
+	case TruncationTruncate:
+		return math.Trunc(f64), nil
+	case TruncationRound:
+		return math.Round(f64), nil
+	default:
+		return 0, fmt.Errorf("cannot decode double %v into an integer type without losing precision: use a truncation "+
+			"policy of TruncationTruncate or TruncationRound, or the \"truncate\" struct tag, to allow lossy conversions", f64)
+	}
+}
+
 func (DefaultValueDecoders) intDecodeType(dc DecodeContext, vr bsonrw.ValueReader, t reflect.Type) (reflect.Value, error) {
 	var i64 int64
 	var err error
@@ -268,8 +289,8 @@ func (DefaultValueDecoders) intDecodeType(dc DecodeContext, vr bsonrw.ValueReade
 		if err != nil {
 			return emptyValue, err
 		}
-		if !dc.Truncate && math.Floor(f64) != f64 {
-			return emptyValue, errCannotTruncate
+		if f64, err = doubleToInteger(dc, f64); err != nil {
+			return emptyValue, err
 		}
 		if f64 > float64(math.MaxInt64) {
 			return emptyValue, fmt.Errorf("%g overflows int64", f64)
@@ -373,8 +394,8 @@ func (dvd DefaultValueDecoders) UintDecodeValue(dc DecodeContext, vr bsonrw.Valu
 		if err != nil {
 			return err
 		}
-		if !dc.Truncate && math.Floor(f64) != f64 {
-			return errors.New("UintDecodeValue can only truncate float64 to an integer type when truncation is enabled")
+		if f64, err = doubleToInteger(dc, f64); err != nil {
+			return err
 		}
 		if f64 > float64(math.MaxInt64) {
 			return fmt.Errorf("%g overflows int64", f64)
@@ -1330,8 +1351,16 @@ func (dvd DefaultValueDecoders) MapDecodeValue(dc DecodeContext, vr bsonrw.Value
 	return nil
 }
 
-// ArrayDecodeValue is the ValueDecoderFunc for array types.
+// ArrayDecodeValue is the ValueDecoderFunc for array types. An error is returned if a BSON array does not have the same
+// length as the Go array. Use ArrayCodec to allow arrays of different lengths.
 func (dvd DefaultValueDecoders) ArrayDecodeValue(dc DecodeContext, vr bsonrw.ValueReader, val reflect.Value) error {
+	return dvd.decodeArray(dc, vr, val, false)
+}
+
+// decodeArray decodes into the Go array val. If allowLengthMismatch is true, a BSON array with fewer elements than val
+// leaves the remaining elements of val set to their zero values and a BSON array with more elements than val is
+// truncated.
+func (dvd DefaultValueDecoders) decodeArray(dc DecodeContext, vr bsonrw.ValueReader, val reflect.Value, allowLengthMismatch bool) error {
 	if !val.IsValid() || val.Kind() != reflect.Array {
 		return ValueDecoderError{Name: "ArrayDecodeValue", Kinds: []reflect.Kind{reflect.Array}, Received: val}
 	}
@@ -1380,17 +1409,28 @@ func (dvd DefaultValueDecoders) ArrayDecodeValue(dc DecodeContext, vr bsonrw.Val
 		elemsFunc = dvd.decodeDefault
 	}
 
+	isArray := vr.Type() == bsontype.Array
 	elems, err := elemsFunc(dc, vr, val)
 	if err != nil {
 		return err
 	}
 
-	if len(elems) > val.Len() {
+	switch {
+	case len(elems) > val.Len() && isArray && allowLengthMismatch:
+		elems = elems[:val.Len()]
+	case len(elems) > val.Len():
 		return fmt.Errorf("more elements returned in array than can fit inside %s, got %v elements", val.Type(), len(elems))
+	case len(elems) < val.Len() && isArray && !allowLengthMismatch:
+		return fmt.Errorf("fewer elements returned in array than needed to fill %s, got %v elements", val.Type(), len(elems))
 	}
 
-	for idx, elem := range elems {
-		val.Index(idx).Set(elem)
+	zero := reflect.Zero(val.Type().Elem())
+	for idx := 0; idx < val.Len(); idx++ {
+		if idx < len(elems) {
+			val.Index(idx).Set(elems[idx])
+			continue
+		}
+		val.Index(idx).Set(zero)
 	}
 
 	return nil
@@ -31,6 +31,11 @@ var (
 	defaultTestStructCodec = newDefaultStructCodec()
 )
 
+// errDoubleTruncation is the error returned when decoding the double 3.14 into an integer type with the default
+// truncation policy.
+var errDoubleTruncation = errors.New("cannot decode double 3.14 into an integer type without losing precision: use a " +
+	"truncation policy of TruncationTruncate or TruncationRound, or the \"truncate\" struct tag, to allow lossy conversions")
+
 func TestDefaultValueDecoders(t *testing.T) {
 	var dvd DefaultValueDecoders
 	var wrong = func(string, string) string { return "wrong" }
@@ -204,7 +209,17 @@ func TestDefaultValueDecoders(t *testing.T) {
 				{
 					"ReadDouble (no truncate)", int64(0), nil,
 					&bsonrwtest.ValueReaderWriter{BSONType: bsontype.Double, Return: float64(3.14)}, bsonrwtest.ReadDouble,
-					errCannotTruncate,
+					errDoubleTruncation,
+				},
+				{
+					"ReadDouble (truncation policy truncate)", int64(3), &DecodeContext{TruncationPolicy: TruncationTruncate},
+					&bsonrwtest.ValueReaderWriter{BSONType: bsontype.Double, Return: float64(3.7)}, bsonrwtest.ReadDouble,
+					nil,
+				},
+				{
+					"ReadDouble (truncation policy round)", int64(4), &DecodeContext{TruncationPolicy: TruncationRound},
+					&bsonrwtest.ValueReaderWriter{BSONType: bsontype.Double, Return: float64(3.7)}, bsonrwtest.ReadDouble,
+					nil,
 				},
 				{
 					"ReadDouble overflows int64", int64(0), nil,
@@ -436,7 +451,17 @@ func TestDefaultValueDecoders(t *testing.T) {
 				{
 					"ReadDouble (no truncate)", uint64(0), nil,
 					&bsonrwtest.ValueReaderWriter{BSONType: bsontype.Double, Return: float64(3.14)}, bsonrwtest.ReadDouble,
-					errCannotTruncate,
+					errDoubleTruncation,
+				},
+				{
+					"ReadDouble (truncation policy truncate)", uint64(3), &DecodeContext{TruncationPolicy: TruncationTruncate},
+					&bsonrwtest.ValueReaderWriter{BSONType: bsontype.Double, Return: float64(3.7)}, bsonrwtest.ReadDouble,
+					nil,
+				},
+				{
+					"ReadDouble (truncation policy round)", uint64(4), &DecodeContext{TruncationPolicy: TruncationRound},
+					&bsonrwtest.ValueReaderWriter{BSONType: bsontype.Double, Return: float64(3.7)}, bsonrwtest.ReadDouble,
+					nil,
 				},
 				{
 					"ReadDouble overflows int64", uint64(0), nil,
@@ -0,0 +1,64 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package bsoncodec
+
+import (
+	"reflect"
+	"sync"
+
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
+)
+
+// DiscriminatorRegistry maps the value of a discriminator field in a BSON document to the Go type that the document
+// should be decoded into. It is used when decoding documents into empty interface values so that polymorphic data can
+// be decoded into concrete types. See DecodeContext.DefaultDocumentDecoder for more information.
+//
+// A DiscriminatorRegistry is safe for concurrent use.
+type DiscriminatorRegistry struct {
+	key string
+
+	mu    sync.RWMutex
+	types map[string]reflect.Type
+}
+
+// NewDiscriminatorRegistry creates a DiscriminatorRegistry that reads the discriminator from the top-level string
+// field named key (e.g. "_t" or "type").
+func NewDiscriminatorRegistry(key string) *DiscriminatorRegistry {
+	return &DiscriminatorRegistry{
+		key:   key,
+		types: make(map[string]reflect.Type),
+	}
+}
+
+// Key returns the name of the discriminator field.
+func (dr *DiscriminatorRegistry) Key() string {
+	return dr.key
+}
+
+// Register associates the discriminator value with t. Documents whose discriminator field is equal to value will be
+// decoded into a value of type t. Registering a value more than once replaces the previously registered type.
+func (dr *DiscriminatorRegistry) Register(value string, t reflect.Type) *DiscriminatorRegistry {
+	dr.mu.Lock()
+	dr.types[value] = t
+	dr.mu.Unlock()
+	return dr
+}
+
+// LookupType returns the type registered for the discriminator value of doc. The second return value is false if doc
+// does not have a string discriminator field or no type has been registered for its value.
+func (dr *DiscriminatorRegistry) LookupType(doc []byte) (reflect.Type, bool) {
+	val, err := bsoncore.Document(doc).LookupErr(dr.key)
+	if err != nil || val.Type != bsontype.String {
+		return nil, false
+	}
+
+	dr.mu.RLock()
+	t, ok := dr.types[val.StringValue()]
+	dr.mu.RUnlock()
+	return t, ok
+}
@@ -94,6 +94,10 @@ func (eic EmptyInterfaceCodec) decodeType(dc DecodeContext, vr bsonrw.ValueReade
 		return emptyValue, ValueDecoderError{Name: "EmptyInterfaceDecodeValue", Types: []reflect.Type{tEmpty}, Received: reflect.Zero(t)}
 	}
 
+	if dc.DefaultDocumentDecoder != nil && (vr.Type() == bsontype.Type(0) || vr.Type() == bsontype.EmbeddedDocument) {
+		return eic.decodeDiscriminatedDocument(dc, vr)
+	}
+
 	rtype, err := eic.getEmptyInterfaceDecodeType(dc, vr.Type())
 	if err != nil {
 		switch vr.Type() {
@@ -124,6 +128,30 @@ func (eic EmptyInterfaceCodec) decodeType(dc DecodeContext, vr bsonrw.ValueReade
 	return elem, nil
 }
 
+// decodeDiscriminatedDocument decodes a document into the type registered in dc.DefaultDocumentDecoder for its
+// discriminator value. If there is no registered type, the document is decoded into the usual type for documents.
+func (eic EmptyInterfaceCodec) decodeDiscriminatedDocument(dc DecodeContext, vr bsonrw.ValueReader) (reflect.Value, error) {
+	valueType := vr.Type()
+	doc, err := bsonrw.Copier{}.CopyDocumentToBytes(vr)
+	if err != nil {
+		return emptyValue, err
+	}
+
+	rtype, ok := dc.DefaultDocumentDecoder.LookupType(doc)
+	if !ok {
+		rtype, err = eic.getEmptyInterfaceDecodeType(dc, valueType)
+		if err != nil {
+			return emptyValue, err
+		}
+	}
+
+	decoder, err := dc.LookupDecoder(rtype)
+	if err != nil {
+		return emptyValue, err
+	}
+	return decodeTypeOrValue(decoder, dc, bsonrw.NewBSONDocumentReader(doc), rtype)
+}
+
 // DecodeValue is the ValueDecoderFunc for interface{}.
 func (eic EmptyInterfaceCodec) DecodeValue(dc DecodeContext, vr bsonrw.ValueReader, val reflect.Value) error {
 	if !val.CanSet() || val.Type() != tEmpty {
@@ -321,7 +321,12 @@ func (sc *StructCodec) DecodeValue(r DecodeContext, vr bsonrw.ValueReader, val r
 		}
 		field = field.Addr()
 
-		dctx := DecodeContext{Registry: r.Registry, Truncate: fd.truncate || r.Truncate}
+		dctx := DecodeContext{
+			Registry:               r.Registry,
+			Truncate:               fd.truncate || r.Truncate,
+			TruncationPolicy:       r.TruncationPolicy,
+			DefaultDocumentDecoder: r.DefaultDocumentDecoder,
+		}
 		if fd.decoder == nil {
 			return newDecodeError(fd.name, ErrNoDecoder{Type: field.Elem().Type()})
 		}
@@ -86,8 +86,8 @@ func (uic *UIntCodec) decodeType(dc DecodeContext, vr bsonrw.ValueReader, t refl
 		if err != nil {
 			return emptyValue, err
 		}
-		if !dc.Truncate && math.Floor(f64) != f64 {
-			return emptyValue, errCannotTruncate
+		if f64, err = doubleToInteger(dc, f64); err != nil {
+			return emptyValue, err
 		}
 		if f64 > float64(math.MaxInt64) {
 			return emptyValue, fmt.Errorf("%g overflows int64", f64)
@@ -0,0 +1,42 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package bsonoptions
+
+var defaultDecodeAllowLengthMismatch = false
+
+// ArrayCodecOptions represents all possible options for array encoding and decoding.
+type ArrayCodecOptions struct {
+	DecodeAllowLengthMismatch *bool // Specifies if BSON arrays can be decoded into Go arrays of a different length. Defaults to false.
+}
+
+// ArrayCodec creates a new *ArrayCodecOptions
+func ArrayCodec() *ArrayCodecOptions {
+	return &ArrayCodecOptions{}
+}
+
+// SetDecodeAllowLengthMismatch specifies if a BSON array whose length differs from the length of the Go array being
+// decoded into should be allowed. If true, missing elements are set to their zero value and extra elements are
+// discarded. If false, an error is returned. Defaults to false.
+func (a *ArrayCodecOptions) SetDecodeAllowLengthMismatch(b bool) *ArrayCodecOptions {
+	a.DecodeAllowLengthMismatch = &b
+	return a
+}
+
+// MergeArrayCodecOptions combines the given *ArrayCodecOptions into a single *ArrayCodecOptions in a last one wins fashion.
+func MergeArrayCodecOptions(opts ...*ArrayCodecOptions) *ArrayCodecOptions {
+	a := &ArrayCodecOptions{&defaultDecodeAllowLengthMismatch}
+	for _, opt := range opts {
+		if opt == nil {
+			continue
+		}
+		if opt.DecodeAllowLengthMismatch != nil {
+			a.DecodeAllowLengthMismatch = opt.DecodeAllowLengthMismatch
+		}
+	}
+
+	return a
+}
@@ -111,6 +111,19 @@ func (d *Decoder) SetRegistry(r *bsoncodec.Registry) error {
 	return nil
 }
 
+// SetTruncationPolicy sets how the decoder handles BSON doubles that are not whole numbers when decoding them into Go
+// integer types. See the bsoncodec.TruncationPolicy documentation for the supported policies.
+func (d *Decoder) SetTruncationPolicy(p bsoncodec.TruncationPolicy) {
+	d.dc.TruncationPolicy = p
+}
+
+// SetDefaultDocumentDecoder sets the DiscriminatorRegistry used to choose the type that documents are decoded into when
+// decoding into an empty interface. Documents whose discriminator value is not registered are decoded as usual. See
+// the bsoncodec.DecodeContext.DefaultDocumentDecoder documentation for more information.
+func (d *Decoder) SetDefaultDocumentDecoder(dr *bsoncodec.DiscriminatorRegistry) {
+	d.dc.DefaultDocumentDecoder = dr
+}
+
 // SetContext replaces the current registry of the decoder with dc.
 func (d *Decoder) SetContext(dc bsoncodec.DecodeContext) error {
 	d.dc = dc
@@ -10,6 +10,7 @@ import (
 	"bytes"
 	"errors"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -235,6 +236,80 @@ func TestDecoderv2(t *testing.T) {
 			t.Errorf("Decoder should use the Registry provided. got %v; want %v", dec.dc, dc2)
 		}
 	})
+	t.Run("SetTruncationPolicy", func(t *testing.T) {
+		type intStruct struct {
+			Count int
+		}
+		data := docToBytes(D{{"count", 3.7}})
+		testCases := []struct {
+			name   string
+			policy bsoncodec.TruncationPolicy
+			want   int
+			errStr string
+		}{
+			{"error", bsoncodec.TruncationError, 0, "error decoding key count: cannot decode double 3.7"},
+			{"truncate", bsoncodec.TruncationTruncate, 3, ""},
+			{"round", bsoncodec.TruncationRound, 4, ""},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				dec, err := NewDecoder(bsonrw.NewBSONDocumentReader(data))
+				noerr(t, err)
+				dec.SetTruncationPolicy(tc.policy)
+
+				var got intStruct
+				err = dec.Decode(&got)
+				if tc.errStr != "" {
+					if err == nil || !strings.HasPrefix(err.Error(), tc.errStr) {
+						t.Fatalf("expected error starting with %q, got %v", tc.errStr, err)
+					}
+					return
+				}
+				noerr(t, err)
+				if got.Count != tc.want {
+					t.Errorf("decoded value mismatch; expected %v, got %v", tc.want, got.Count)
+				}
+			})
+		}
+	})
+	t.Run("SetDefaultDocumentDecoder", func(t *testing.T) {
+		type circle struct {
+			Kind   string `bson:"_t"`
+			Radius float64
+		}
+		type shapes struct {
+			Shapes []interface{}
+			Nested interface{}
+		}
+		data := docToBytes(D{
+			{"shapes", A{
+				D{{"_t", "circle"}, {"radius", 1.5}},
+				D{{"_t", "square"}, {"side", 2.0}},
+				D{{"radius", 3.0}},
+			}},
+			{"nested", D{{"inner", D{{"_t", "circle"}, {"radius", 4.5}}}}},
+		})
+		dr := bsoncodec.NewDiscriminatorRegistry("_t").Register("circle", reflect.TypeOf(circle{}))
+
+		dec, err := NewDecoder(bsonrw.NewBSONDocumentReader(data))
+		noerr(t, err)
+		dec.SetDefaultDocumentDecoder(dr)
+
+		var got shapes
+		err = dec.Decode(&got)
+		noerr(t, err)
+		want := shapes{
+			Shapes: []interface{}{
+				circle{Kind: "circle", Radius: 1.5},
+				D{{"_t", "square"}, {"side", 2.0}},
+				D{{"radius", 3.0}},
+			},
+			Nested: D{{"inner", circle{Kind: "circle", Radius: 4.5}}},
+		}
+		if !cmp.Equal(got, want) {
+			t.Errorf("decoded value mismatch; expected %v, got %v", want, got)
+		}
+	})
 	t.Run("DecodeToNil", func(t *testing.T) {
 		data := docToBytes(D{{"item", "canvas"}, {"qty", 4}})
 		vr := bsonrw.NewBSONDocumentReader(data)
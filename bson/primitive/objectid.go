@@ -34,11 +34,41 @@ var NilObjectID ObjectID
 var objectIDCounter = readRandomUint32()
 var processUnique = processUniqueBytes()
 
-// NewObjectID generates a new ObjectID.
+// objectIDGenerator holds the function set by SetObjectIDGenerator. It stores a generatorFunc because atomic.Value
+// cannot store nil.
+var objectIDGenerator atomic.Value
+
+type generatorFunc func() ObjectID
+
+// NewObjectID generates a new ObjectID. If a generator has been set using SetObjectIDGenerator, the ObjectID is
+// returned by that generator.
 func NewObjectID() ObjectID {
+	if gen, _ := objectIDGenerator.Load().(generatorFunc); gen != nil {
+		return gen()
+	}
 	return NewObjectIDFromTimestamp(time.Now())
 }
 
+// SetObjectIDGenerator overrides the function used by NewObjectID to generate ObjectIDs, including the _id values
+// added by the driver to inserted documents. Passing nil restores the default generator. The generator must be safe
+// for concurrent use.
+//
+// This function is intended for tests that need reproducible ObjectIDs (e.g. generators built on
+// NewObjectIDFromCounter) and should not be used in production code, because ObjectIDs produced by a custom generator
+// are not guaranteed to be unique across processes.
+func SetObjectIDGenerator(gen func() ObjectID) {
+	objectIDGenerator.Store(generatorFunc(gen))
+}
+
+// NewObjectIDFromCounter creates a deterministic ObjectID from counter. The timestamp and process-unique bytes of the
+// ObjectID are zero and the last four bytes hold counter in big-endian order, so ObjectIDs created from increasing
+// counters sort in the same order as the counters.
+func NewObjectIDFromCounter(counter uint32) ObjectID {
+	var b [12]byte
+	binary.BigEndian.PutUint32(b[8:12], counter)
+	return b
+}
+
 // NewObjectIDFromTimestamp generates a new ObjectID based on the given time.
 func NewObjectIDFromTimestamp(timestamp time.Time) ObjectID {
 	var b [12]byte
@@ -88,6 +118,27 @@ func ObjectIDFromHex(s string) (ObjectID, error) {
 	return oid, nil
 }
 
+// TryObjectIDFromHex creates a new ObjectID from a hex string. It returns false instead of an error if the hex string
+// is not a valid ObjectID, which makes it convenient for validating user input such as URL path parameters.
+func TryObjectIDFromHex(s string) (ObjectID, bool) {
+	oid, err := ObjectIDFromHex(s)
+	if err != nil {
+		return NilObjectID, false
+	}
+	return oid, true
+}
+
+// MustObjectIDFromHex creates a new ObjectID from a hex string. It panics if the hex string is not a valid ObjectID.
+// It is intended for ObjectIDs that are known to be valid, such as constants and test fixtures, and should not be
+// used with user input.
+func MustObjectIDFromHex(s string) ObjectID {
+	oid, err := ObjectIDFromHex(s)
+	if err != nil {
+		panic(err)
+	}
+	return oid
+}
+
 // MarshalJSON returns the ObjectID as a string
 func (id ObjectID) MarshalJSON() ([]byte, error) {
 	return json.Marshal(id.Hex())
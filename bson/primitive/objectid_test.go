@@ -46,6 +46,25 @@ func TestFromHex_WrongLength(t *testing.T) {
 	require.Equal(t, ErrInvalidHex, err)
 }
 
+func TestTryObjectIDFromHex(t *testing.T) {
+	before := NewObjectID()
+	after, ok := TryObjectIDFromHex(before.Hex())
+	require.True(t, ok)
+	require.Equal(t, before, after)
+
+	for _, s := range []string{"", "deadbeef", "this is not a valid hex string!"} {
+		oid, ok := TryObjectIDFromHex(s)
+		require.False(t, ok, "expected %q to be invalid", s)
+		require.Equal(t, NilObjectID, oid)
+	}
+}
+
+func TestMustObjectIDFromHex(t *testing.T) {
+	before := NewObjectID()
+	require.Equal(t, before, MustObjectIDFromHex(before.Hex()))
+	require.Panics(t, func() { MustObjectIDFromHex("deadbeef") })
+}
+
 func TestTimeStamp(t *testing.T) {
 	testCases := []struct {
 		Hex      string
@@ -151,6 +170,25 @@ func TestCounterOverflow(t *testing.T) {
 	require.Equal(t, uint32(0), objectIDCounter)
 }
 
+func TestSetObjectIDGenerator(t *testing.T) {
+	var counter uint32
+	SetObjectIDGenerator(func() ObjectID {
+		counter++
+		return NewObjectIDFromCounter(counter)
+	})
+	defer SetObjectIDGenerator(nil)
+
+	first := NewObjectID()
+	second := NewObjectID()
+	require.Equal(t, "000000000000000000000001", first.Hex())
+	require.Equal(t, "000000000000000000000002", second.Hex())
+
+	SetObjectIDGenerator(nil)
+	id := NewObjectID()
+	require.NotEqual(t, NewObjectIDFromCounter(3), id)
+	require.True(t, id.Timestamp().After(time.Unix(0, 0)))
+}
+
 func TestObjectID_UnmarshalJSON(t *testing.T) {
 	oid := NewObjectID()
 
@@ -10,11 +10,25 @@ package primitive // import "go.mongodb.org/mongo-driver/bson/primitive"
 
 import (
 	"bytes"
+	"crypto/rand"
 	"encoding/json"
 	"fmt"
+	"io"
 	"time"
 )
 
+// These constants are the BSON binary subtypes that can be used for the Subtype field of a Binary.
+const (
+	BinaryGeneric     byte = 0x00
+	BinaryFunction    byte = 0x01
+	BinaryBinaryOld   byte = 0x02
+	BinaryUUIDOld     byte = 0x03
+	BinaryUUID        byte = 0x04
+	BinaryMD5         byte = 0x05
+	BinaryEncrypted   byte = 0x06
+	BinaryUserDefined byte = 0x80
+)
+
 // Binary represents a BSON binary value.
 type Binary struct {
 	Subtype byte
@@ -34,6 +48,37 @@ func (bp Binary) IsZero() bool {
 	return bp.Subtype == 0 && len(bp.Data) == 0
 }
 
+// AsUUID returns the UUID stored in bp. The ok return value is false if bp does not have the BinaryUUID subtype or if
+// its data is not exactly 16 bytes long.
+func (bp Binary) AsUUID() (uuid [16]byte, ok bool) {
+	if bp.Subtype != BinaryUUID || len(bp.Data) != 16 {
+		return uuid, false
+	}
+
+	copy(uuid[:], bp.Data)
+	return uuid, true
+}
+
+// BinaryFromUUID creates a Binary with the BinaryUUID subtype that holds the given UUID.
+func BinaryFromUUID(uuid [16]byte) Binary {
+	data := make([]byte, 16)
+	copy(data, uuid[:])
+	return Binary{Subtype: BinaryUUID, Data: data}
+}
+
+// NewUUID generates a new random (version 4) UUID and returns it as a Binary with the BinaryUUID subtype.
+func NewUUID() Binary {
+	var uuid [16]byte
+	_, err := io.ReadFull(rand.Reader, uuid[:])
+	if err != nil {
+		panic(fmt.Errorf("cannot generate UUID with crypto.rand.Reader: %v", err))
+	}
+	uuid[6] = (uuid[6] & 0x0f) | 0x40 // Version 4
+	uuid[8] = (uuid[8] & 0x3f) | 0x80 // Variant is 10
+
+	return BinaryFromUUID(uuid)
+}
+
 // Undefined represents the BSON undefined value type.
 type Undefined struct{}
 
@@ -62,6 +62,34 @@ func TestPrimitiveIsZero(t *testing.T) {
 	}
 }
 
+func TestBinaryUUID(t *testing.T) {
+	t.Run("round trip", func(t *testing.T) {
+		uuid := [16]byte{0x01, 0x23, 0x45, 0x67, 0x89, 0xab, 0xcd, 0xef, 0x01, 0x23, 0x45, 0x67, 0x89, 0xab, 0xcd, 0xef}
+		bin := BinaryFromUUID(uuid)
+		assert.Equal(t, BinaryUUID, bin.Subtype, "expected subtype %v, got %v", BinaryUUID, bin.Subtype)
+
+		got, ok := bin.AsUUID()
+		assert.True(t, ok, "expected AsUUID to succeed")
+		assert.Equal(t, uuid, got, "expected UUID %v, got %v", uuid, got)
+	})
+	t.Run("new", func(t *testing.T) {
+		bin := NewUUID()
+		uuid, ok := bin.AsUUID()
+		assert.True(t, ok, "expected AsUUID to succeed")
+		assert.Equal(t, byte(0x40), uuid[6]&0xf0, "expected version 4 UUID, got version byte %x", uuid[6])
+		assert.Equal(t, byte(0x80), uuid[8]&0xc0, "expected RFC 4122 variant, got variant byte %x", uuid[8])
+		assert.False(t, bin.Equal(NewUUID()), "expected different UUIDs to be generated")
+	})
+	t.Run("wrong subtype", func(t *testing.T) {
+		_, ok := Binary{Subtype: BinaryUUIDOld, Data: make([]byte, 16)}.AsUUID()
+		assert.False(t, ok, "expected AsUUID to fail for subtype %v", BinaryUUIDOld)
+	})
+	t.Run("wrong length", func(t *testing.T) {
+		_, ok := Binary{Subtype: BinaryUUID, Data: make([]byte, 15)}.AsUUID()
+		assert.False(t, ok, "expected AsUUID to fail for 15 bytes of data")
+	})
+}
+
 func TestRegexCompare(t *testing.T) {
 	testcases := []struct {
 		name string
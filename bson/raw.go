@@ -85,6 +85,41 @@ func (r Raw) IndexErr(index uint) (RawElement, error) {
 // String implements the fmt.Stringer interface.
 func (r Raw) String() string { return bsoncore.Document(r).String() }
 
+// ToD decodes r into a D using the default registry. Embedded documents are decoded as D values and arrays as A
+// values, and every other BSON value is decoded into the primitive type that represents it (e.g. int32, int64,
+// primitive.Decimal128, primitive.Timestamp, or primitive.DateTime). Element order is preserved, so encoding the
+// returned D with DToRaw produces the same bytes as r.
+func (r Raw) ToD() (D, error) {
+	var d D
+	if err := Unmarshal(r, &d); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// ToM decodes r into an M using the default registry. Embedded documents are decoded as M values and arrays as A
+// values. Other BSON values are decoded as described in ToD. Go maps are unordered, so encoding the returned M with
+// MToRaw preserves every value and its BSON type but not the order of the elements.
+func (r Raw) ToM() (M, error) {
+	var m M
+	if err := Unmarshal(r, &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// DToRaw encodes d into a Raw using the default registry. For a D returned by Raw.ToD, the result is identical to the
+// original Raw.
+func DToRaw(d D) (Raw, error) {
+	return Marshal(d)
+}
+
+// MToRaw encodes m into a Raw using the default registry. For an M returned by Raw.ToM, the result contains the same
+// elements as the original Raw, although possibly in a different order.
+func MToRaw(m M) (Raw, error) {
+	return Marshal(m)
+}
+
 // readi32 is a helper function for reading an int32 from slice of bytes.
 func readi32(b []byte) int32 {
 	_ = b[3] // bounds check hint to compiler; see golang.org/issue/14808
@@ -16,6 +16,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/stretchr/testify/require"
 	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
 )
 
@@ -345,3 +346,87 @@ func TestRaw(t *testing.T) {
 		}
 	})
 }
+
+func TestRawConversion(t *testing.T) {
+	oid := [12]byte{0x5f, 0x1a, 0x2b, 0x3c, 0x4d, 0x5e, 0x6f, 0x70, 0x81, 0x92, 0xa3, 0xb4}
+	scope := bsoncore.BuildDocumentFromElements(nil, bsoncore.AppendInt32Element(nil, "x", 1))
+	nested := bsoncore.BuildDocumentFromElements(nil,
+		bsoncore.AppendStringElement(nil, "b", "nested"),
+		bsoncore.AppendInt64Element(nil, "a", 1),
+	)
+	arr := bsoncore.BuildArray(nil,
+		bsoncore.Value{Type: bsontype.Int32, Data: bsoncore.AppendInt32(nil, 1)},
+		bsoncore.Value{Type: bsontype.EmbeddedDocument, Data: nested},
+	)
+	// Every BSON type, with keys out of lexicographic order to check that order is preserved.
+	raw := Raw(bsoncore.BuildDocumentFromElements(nil,
+		bsoncore.AppendDoubleElement(nil, "z", 3.0),
+		bsoncore.AppendStringElement(nil, "y", "hello"),
+		bsoncore.AppendDocumentElement(nil, "x", nested),
+		bsoncore.AppendArrayElement(nil, "w", arr),
+		bsoncore.AppendBinaryElement(nil, "v", 0x00, []byte{1, 2, 3}),
+		bsoncore.AppendBinaryElement(nil, "u", 0x04, []byte{4, 5, 6}),
+		bsoncore.AppendUndefinedElement(nil, "t"),
+		bsoncore.AppendObjectIDElement(nil, "s", oid),
+		bsoncore.AppendBooleanElement(nil, "r", true),
+		bsoncore.AppendDateTimeElement(nil, "q", 1600000000000),
+		bsoncore.AppendNullElement(nil, "p"),
+		bsoncore.AppendRegexElement(nil, "o", "^a", "i"),
+		bsoncore.AppendDBPointerElement(nil, "n", "db.coll", oid),
+		bsoncore.AppendJavaScriptElement(nil, "m", "function() {}"),
+		bsoncore.AppendSymbolElement(nil, "l", "symbol"),
+		bsoncore.AppendCodeWithScopeElement(nil, "k", "function() { return x; }", scope),
+		bsoncore.AppendInt32Element(nil, "j", 32),
+		bsoncore.AppendTimestampElement(nil, "i", 12345, 6),
+		bsoncore.AppendInt64Element(nil, "h", 64),
+		bsoncore.AppendDecimal128Element(nil, "g", primitive.NewDecimal128(0x3040000000000000, 0x7b)),
+		bsoncore.AppendMinKeyElement(nil, "f"),
+		bsoncore.AppendMaxKeyElement(nil, "e"),
+	))
+
+	t.Run("D round trip", func(t *testing.T) {
+		d, err := raw.ToD()
+		noerr(t, err)
+		got, err := DToRaw(d)
+		noerr(t, err)
+		if !bytes.Equal(raw, got) {
+			t.Errorf("round trip through D did not preserve the document. got %v; want %v", got, raw)
+		}
+	})
+	t.Run("M round trip", func(t *testing.T) {
+		m, err := raw.ToM()
+		noerr(t, err)
+		got, err := MToRaw(m)
+		noerr(t, err)
+
+		elems, err := raw.Elements()
+		noerr(t, err)
+		gotElems, err := got.Elements()
+		noerr(t, err)
+		if len(gotElems) != len(elems) {
+			t.Fatalf("expected %d elements, got %d", len(elems), len(gotElems))
+		}
+		for _, elem := range elems {
+			key := elem.Key()
+			gotVal, err := got.LookupErr(key)
+			noerr(t, err)
+			want := elem.Value()
+			if key == "x" || key == "w" {
+				// Embedded documents are decoded as M, so only their contents and not their order are preserved.
+				if gotVal.Type != want.Type {
+					t.Errorf("type mismatch for key %q. got %v; want %v", key, gotVal.Type, want.Type)
+				}
+				continue
+			}
+			if !gotVal.Equal(want) {
+				t.Errorf("value mismatch for key %q. got %v; want %v", key, gotVal, want)
+			}
+		}
+	})
+	t.Run("invalid document", func(t *testing.T) {
+		_, err := Raw{0x05, 0x00}.ToD()
+		if err == nil {
+			t.Error("expected error, got nil")
+		}
+	})
+}
@@ -22,6 +22,9 @@ type CommandStartedEvent struct {
 	CommandName  string
 	RequestID    int64
 	ConnectionID string
+	// OperationName is the application-level operation name set on the operation's context using
+	// mongo.WithOperationName. It is empty if no name was set.
+	OperationName string
 }
 
 // CommandFinishedEvent represents a generic command finishing.
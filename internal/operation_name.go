@@ -0,0 +1,25 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package internal
+
+import "context"
+
+type operationNameKey struct{}
+
+// WithOperationName returns a copy of ctx that carries the given application-level operation name.
+func WithOperationName(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, operationNameKey{}, name)
+}
+
+// OperationName returns the operation name stored in ctx by WithOperationName, or an empty string if there is none.
+func OperationName(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	name, _ := ctx.Value(operationNameKey{}).(string)
+	return name
+}
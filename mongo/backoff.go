@@ -0,0 +1,137 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/x/mongo/driver"
+)
+
+// BackoffPolicy determines how long to wait between attempts of a retried operation.
+type BackoffPolicy interface {
+	// Backoff returns the amount of time to wait before the given retry attempt. The attempt parameter is 1 for the
+	// first retry, 2 for the second, and so on.
+	Backoff(attempt int) time.Duration
+}
+
+// BackoffPolicyFunc is an adapter to allow the use of ordinary functions as a BackoffPolicy.
+type BackoffPolicyFunc func(attempt int) time.Duration
+
+// Backoff implements the BackoffPolicy interface.
+func (bf BackoffPolicyFunc) Backoff(attempt int) time.Duration {
+	return bf(attempt)
+}
+
+// NoBackoff is a BackoffPolicy that retries immediately. This matches the behavior of the retries done internally by
+// the driver.
+var NoBackoff BackoffPolicy = BackoffPolicyFunc(func(int) time.Duration { return 0 })
+
+type exponentialBackoff struct {
+	base   time.Duration
+	max    time.Duration
+	jitter float64
+
+	mu   sync.Mutex
+	rand *rand.Rand
+}
+
+// ExponentialBackoff returns a BackoffPolicy that waits base before the first retry and doubles the wait for every
+// subsequent retry, up to max. If jitter is greater than zero, each wait is randomly reduced by up to that fraction
+// of its value so that concurrent clients do not retry in lockstep. For example, a jitter of 0.2 produces waits
+// between 80% and 100% of the computed value. The jitter is clamped to the range [0, 1].
+func ExponentialBackoff(base, max time.Duration, jitter float64) BackoffPolicy {
+	if jitter < 0 {
+		jitter = 0
+	}
+	if jitter > 1 {
+		jitter = 1
+	}
+	return &exponentialBackoff{
+		base:   base,
+		max:    max,
+		jitter: jitter,
+		rand:   rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+// Backoff implements the BackoffPolicy interface.
+func (eb *exponentialBackoff) Backoff(attempt int) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+
+	wait := eb.base
+	for i := 1; i < attempt && wait < eb.max; i++ {
+		wait *= 2
+	}
+	if wait > eb.max {
+		wait = eb.max
+	}
+
+	if eb.jitter > 0 {
+		eb.mu.Lock()
+		f := eb.rand.Float64()
+		eb.mu.Unlock()
+		wait -= time.Duration(float64(wait) * eb.jitter * f)
+	}
+	return wait
+}
+
+// RetryOperation calls fn until it succeeds, returns an error that is not retryable, or has been called maxAttempts
+// times. Between attempts, RetryOperation waits for the duration returned by policy. If policy is nil, NoBackoff is
+// used. An error is retryable if it has the RetryableWriteError, TransientTransactionError, or NetworkError label.
+//
+// If ctx expires while waiting between attempts, the error from the last attempt is returned. The ctx parameter is
+// passed to each call of fn.
+func RetryOperation(ctx context.Context, maxAttempts int, policy BackoffPolicy, fn func(context.Context) error) error {
+	if maxAttempts < 1 {
+		return errors.New("maxAttempts must be positive")
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if policy == nil {
+		policy = NoBackoff
+	}
+
+	var err error
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		if attempt > 0 {
+			if wait := policy.Backoff(attempt); wait > 0 {
+				timer := time.NewTimer(wait)
+				select {
+				case <-ctx.Done():
+					timer.Stop()
+					return err
+				case <-timer.C:
+				}
+			}
+		}
+
+		err = fn(ctx)
+		if err == nil || !isRetryableError(err) {
+			return err
+		}
+	}
+	return err
+}
+
+// isRetryableError returns true if err has an error label indicating that the operation can be retried.
+func isRetryableError(err error) bool {
+	labeled, ok := err.(interface{ HasErrorLabel(string) bool })
+	if !ok {
+		return false
+	}
+	return labeled.HasErrorLabel(driver.RetryableWriteError) ||
+		labeled.HasErrorLabel(driver.TransientTransactionError) ||
+		labeled.HasErrorLabel(driver.NetworkError)
+}
@@ -0,0 +1,99 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/internal/testutil/assert"
+	"go.mongodb.org/mongo-driver/x/mongo/driver"
+)
+
+func TestExponentialBackoff(t *testing.T) {
+	t.Run("without jitter", func(t *testing.T) {
+		policy := ExponentialBackoff(10*time.Millisecond, 50*time.Millisecond, 0)
+		expected := []time.Duration{
+			10 * time.Millisecond,
+			20 * time.Millisecond,
+			40 * time.Millisecond,
+			50 * time.Millisecond,
+			50 * time.Millisecond,
+		}
+		for i, want := range expected {
+			got := policy.Backoff(i + 1)
+			assert.Equal(t, want, got, "expected backoff %v for attempt %v, got %v", want, i+1, got)
+		}
+	})
+	t.Run("with jitter", func(t *testing.T) {
+		policy := ExponentialBackoff(100*time.Millisecond, time.Second, 0.5)
+		for i := 0; i < 100; i++ {
+			got := policy.Backoff(2)
+			assert.True(t, got >= 100*time.Millisecond && got <= 200*time.Millisecond,
+				"expected backoff between 100ms and 200ms, got %v", got)
+		}
+	})
+}
+
+func TestRetryOperation(t *testing.T) {
+	retryableErr := CommandError{Labels: []string{driver.RetryableWriteError}}
+
+	t.Run("retries retryable errors", func(t *testing.T) {
+		var attempts []int
+		policy := BackoffPolicyFunc(func(attempt int) time.Duration {
+			attempts = append(attempts, attempt)
+			return time.Millisecond
+		})
+		calls := 0
+		err := RetryOperation(context.Background(), 5, policy, func(context.Context) error {
+			calls++
+			if calls < 3 {
+				return retryableErr
+			}
+			return nil
+		})
+		assert.Nil(t, err, "RetryOperation error: %v", err)
+		assert.Equal(t, 3, calls, "expected 3 calls, got %v", calls)
+		assert.Equal(t, []int{1, 2}, attempts, "expected backoff attempts [1 2], got %v", attempts)
+	})
+	t.Run("does not retry other errors", func(t *testing.T) {
+		want := errors.New("not retryable")
+		calls := 0
+		err := RetryOperation(context.Background(), 5, nil, func(context.Context) error {
+			calls++
+			return want
+		})
+		assert.Equal(t, want, err, "expected error %v, got %v", want, err)
+		assert.Equal(t, 1, calls, "expected 1 call, got %v", calls)
+	})
+	t.Run("stops after max attempts", func(t *testing.T) {
+		calls := 0
+		err := RetryOperation(context.Background(), 3, nil, func(context.Context) error {
+			calls++
+			return retryableErr
+		})
+		assert.Equal(t, retryableErr, err, "expected error %v, got %v", retryableErr, err)
+		assert.Equal(t, 3, calls, "expected 3 calls, got %v", calls)
+	})
+	t.Run("stops when context expires", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		calls := 0
+		err := RetryOperation(ctx, 5, ExponentialBackoff(time.Minute, time.Minute, 0), func(context.Context) error {
+			calls++
+			return retryableErr
+		})
+		assert.Equal(t, retryableErr, err, "expected error %v, got %v", retryableErr, err)
+		assert.Equal(t, 1, calls, "expected 1 call, got %v", calls)
+	})
+	t.Run("invalid max attempts", func(t *testing.T) {
+		err := RetryOperation(context.Background(), 0, nil, func(context.Context) error { return nil })
+		assert.NotNil(t, err, "expected error, got nil")
+	})
+}
@@ -3,6 +3,7 @@ package mongo
 import (
 	"context"
 
+	"go.mongodb.org/mongo-driver/mongo/description"
 	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
 	"go.mongodb.org/mongo-driver/x/mongo/driver"
 )
@@ -30,6 +31,11 @@ type batchCursor interface {
 	Close(context.Context) error
 }
 
+// serverDescriber is implemented by batchCursors that can report the description of the server they were created on.
+type serverDescriber interface {
+	ServerDescription() description.Server
+}
+
 // changeStreamCursor is the interface implemented by batch cursors that also provide the functionality for retrieving
 // a postBatchResumeToken from commands and allows for the cursor to be killed rather than closed
 type changeStreamCursor interface {
@@ -0,0 +1,195 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/description"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// These are the batch limits used by a BatchInserter if the server does not report its own limits.
+const (
+	defaultInserterBatchSize  = 100000
+	defaultInserterBatchBytes = 16 * 1024 * 1024
+)
+
+// ErrBatchInserterClosed is returned by BatchInserter methods after Close has been called.
+var ErrBatchInserterClosed = errors.New("batch inserter is closed")
+
+// BatchInserterOptions represents options that can be used to configure a BatchInserter.
+type BatchInserterOptions struct {
+	// The maximum number of documents to buffer before the BatchInserter flushes them. If this is 0, the maximum write
+	// batch size reported by the server is used.
+	BatchSize int
+
+	// The maximum total size in bytes of the documents to buffer before the BatchInserter flushes them. If this is 0,
+	// the maximum BSON document size reported by the server is used, so each flush fits in a single insert command.
+	MaxBatchBytes int
+
+	// The options to use for the InsertMany operation run by each flush.
+	InsertOptions *options.InsertManyOptions
+}
+
+// BatchInserter buffers documents and inserts them into a collection in batches. A batch is flushed when it reaches
+// the configured number of documents or total size, which bounds the memory used by the buffer. Flushes are done
+// synchronously by the call to Add that fills the batch, so a caller producing documents faster than they can be
+// inserted is slowed to the rate of the server.
+//
+// If a flush fails, the error is returned by the call that caused the flush and by all subsequent calls to Add,
+// Flush, and Close. The documents in the failed batch are not retried.
+//
+// A BatchInserter is safe for concurrent use by multiple goroutines.
+type BatchInserter struct {
+	coll *Collection
+	ctx  context.Context
+	opts BatchInserterOptions
+
+	mu        sync.Mutex
+	docs      []interface{}
+	size      int
+	limitsSet bool
+	err       error
+	closed    bool
+}
+
+// NewBatchInserter creates a BatchInserter for the collection. The ctx parameter is used for every insert done by the
+// BatchInserter and for selecting a server to determine the batch limits that are not set in opts.
+func (coll *Collection) NewBatchInserter(ctx context.Context, opts BatchInserterOptions) *BatchInserter {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return &BatchInserter{coll: coll, ctx: ctx, opts: opts}
+}
+
+// Add adds a document to the current batch, flushing the batch if it is full. The document parameter must be a
+// document to be inserted. It cannot be nil. If the document does not have an _id field when transformed into BSON,
+// one will be added automatically when it is inserted.
+func (bi *BatchInserter) Add(document interface{}) error {
+	if document == nil {
+		return ErrNilDocument
+	}
+
+	bi.mu.Lock()
+	defer bi.mu.Unlock()
+
+	if bi.closed {
+		return ErrBatchInserterClosed
+	}
+	if bi.err != nil {
+		return bi.err
+	}
+	if !bi.limitsSet {
+		if err := bi.setLimits(); err != nil {
+			return err
+		}
+	}
+
+	doc, err := transformBsoncoreDocument(bi.coll.registry, document)
+	if err != nil {
+		return err
+	}
+
+	// Flush before adding a document that would push the batch over the size limit so that the buffer stays bounded.
+	if len(bi.docs) > 0 && bi.size+len(doc) > bi.opts.MaxBatchBytes {
+		if err = bi.flush(); err != nil {
+			return err
+		}
+	}
+
+	bi.docs = append(bi.docs, bson.Raw(doc))
+	bi.size += len(doc)
+	if len(bi.docs) >= bi.opts.BatchSize || bi.size >= bi.opts.MaxBatchBytes {
+		return bi.flush()
+	}
+	return nil
+}
+
+// Flush inserts all buffered documents.
+func (bi *BatchInserter) Flush() error {
+	bi.mu.Lock()
+	defer bi.mu.Unlock()
+
+	if bi.closed {
+		return ErrBatchInserterClosed
+	}
+	return bi.flush()
+}
+
+// Close flushes all buffered documents and closes the BatchInserter. Add and Flush must not be called after Close has
+// been called.
+func (bi *BatchInserter) Close() error {
+	bi.mu.Lock()
+	defer bi.mu.Unlock()
+
+	if bi.closed {
+		return ErrBatchInserterClosed
+	}
+	bi.closed = true
+	return bi.flush()
+}
+
+// flush inserts the buffered documents. The caller must hold bi.mu.
+func (bi *BatchInserter) flush() error {
+	if bi.err != nil {
+		return bi.err
+	}
+	if len(bi.docs) == 0 {
+		return nil
+	}
+
+	docs := bi.docs
+	bi.docs = nil
+	bi.size = 0
+
+	var opts []*options.InsertManyOptions
+	if bi.opts.InsertOptions != nil {
+		opts = append(opts, bi.opts.InsertOptions)
+	}
+	if _, err := bi.coll.InsertMany(bi.ctx, docs, opts...); err != nil {
+		bi.err = err
+		return err
+	}
+	return nil
+}
+
+// setLimits fills in the batch limits that are not set in the options using the limits reported by a server
+// selected for writing. The caller must hold bi.mu.
+func (bi *BatchInserter) setLimits() error {
+	if bi.opts.BatchSize <= 0 || bi.opts.MaxBatchBytes <= 0 {
+		batchSize, batchBytes := defaultInserterBatchSize, defaultInserterBatchBytes
+
+		srvr, err := bi.coll.client.deployment.SelectServer(bi.ctx, bi.coll.writeSelector)
+		if err != nil {
+			return replaceErrors(err)
+		}
+		if ds, ok := srvr.(interface {
+			Description() description.SelectedServer
+		}); ok {
+			desc := ds.Description()
+			if desc.MaxBatchCount > 0 {
+				batchSize = int(desc.MaxBatchCount)
+			}
+			if desc.MaxDocumentSize > 0 {
+				batchBytes = int(desc.MaxDocumentSize)
+			}
+		}
+
+		if bi.opts.BatchSize <= 0 {
+			bi.opts.BatchSize = batchSize
+		}
+		if bi.opts.MaxBatchBytes <= 0 {
+			bi.opts.MaxBatchBytes = batchBytes
+		}
+	}
+	bi.limitsSet = true
+	return nil
+}
@@ -0,0 +1,95 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"context"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/internal/testutil/assert"
+	"go.mongodb.org/mongo-driver/mongo/description"
+	"go.mongodb.org/mongo-driver/x/mongo/driver"
+)
+
+// describedServer is a driver.Server that reports a fixed server description.
+type describedServer struct {
+	desc description.SelectedServer
+}
+
+func (ds describedServer) Connection(context.Context) (driver.Connection, error) {
+	return nil, nil
+}
+
+func (ds describedServer) Description() description.SelectedServer {
+	return ds.desc
+}
+
+// describedServerDeployment is a mockDeployment that selects a describedServer.
+type describedServerDeployment struct {
+	mockDeployment
+	server describedServer
+}
+
+func (dsd describedServerDeployment) SelectServer(context.Context, description.ServerSelector) (driver.Server, error) {
+	return dsd.server, nil
+}
+
+func TestBatchInserter(t *testing.T) {
+	t.Run("nil document", func(t *testing.T) {
+		bi := setupColl("foo").NewBatchInserter(bgCtx, BatchInserterOptions{BatchSize: 2, MaxBatchBytes: 1024})
+		err := bi.Add(nil)
+		assert.Equal(t, ErrNilDocument, err, "expected error %v, got %v", ErrNilDocument, err)
+	})
+	t.Run("flush errors are returned by later calls", func(t *testing.T) {
+		bi := setupColl("foo").NewBatchInserter(bgCtx, BatchInserterOptions{BatchSize: 2, MaxBatchBytes: 1024})
+		err := bi.Add(bson.D{{"x", 1}})
+		assert.Nil(t, err, "Add error: %v", err)
+
+		err = bi.Add(bson.D{{"x", 2}})
+		assert.Equal(t, ErrClientDisconnected, err, "expected error %v, got %v", ErrClientDisconnected, err)
+		err = bi.Add(bson.D{{"x", 3}})
+		assert.Equal(t, ErrClientDisconnected, err, "expected error %v, got %v", ErrClientDisconnected, err)
+		err = bi.Close()
+		assert.Equal(t, ErrClientDisconnected, err, "expected error %v, got %v", ErrClientDisconnected, err)
+
+		err = bi.Add(bson.D{{"x", 4}})
+		assert.Equal(t, ErrBatchInserterClosed, err, "expected error %v, got %v", ErrBatchInserterClosed, err)
+	})
+	t.Run("byte threshold", func(t *testing.T) {
+		doc := bson.D{{"x", "some value"}}
+		raw, err := bson.Marshal(doc)
+		assert.Nil(t, err, "Marshal error: %v", err)
+
+		bi := setupColl("foo").NewBatchInserter(bgCtx, BatchInserterOptions{BatchSize: 10, MaxBatchBytes: len(raw) * 2})
+		err = bi.Add(doc)
+		assert.Nil(t, err, "Add error: %v", err)
+		err = bi.Add(doc)
+		assert.Equal(t, ErrClientDisconnected, err, "expected error %v, got %v", ErrClientDisconnected, err)
+	})
+	t.Run("limits from server description", func(t *testing.T) {
+		client := setupClient()
+		client.deployment = describedServerDeployment{server: describedServer{desc: description.SelectedServer{
+			Server: description.Server{MaxBatchCount: 50, MaxDocumentSize: 4096},
+		}}}
+		coll := client.Database("foo").Collection("bar")
+
+		bi := coll.NewBatchInserter(bgCtx, BatchInserterOptions{})
+		err := bi.setLimits()
+		assert.Nil(t, err, "setLimits error: %v", err)
+		assert.Equal(t, 50, bi.opts.BatchSize, "expected batch size %v, got %v", 50, bi.opts.BatchSize)
+		assert.Equal(t, 4096, bi.opts.MaxBatchBytes, "expected max batch bytes %v, got %v", 4096,
+			bi.opts.MaxBatchBytes)
+
+		bi = coll.NewBatchInserter(bgCtx, BatchInserterOptions{BatchSize: 5})
+		err = bi.setLimits()
+		assert.Nil(t, err, "setLimits error: %v", err)
+		assert.Equal(t, 5, bi.opts.BatchSize, "expected batch size %v, got %v", 5, bi.opts.BatchSize)
+		assert.Equal(t, 4096, bi.opts.MaxBatchBytes, "expected max batch bytes %v, got %v", 4096,
+			bi.opts.MaxBatchBytes)
+	})
+}
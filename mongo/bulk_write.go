@@ -179,9 +179,10 @@ func (bw *bulkWrite) runInsert(ctx context.Context, batch bulkWriteBatch) (opera
 
 	op := operation.NewInsert(docs...).
 		Session(bw.session).WriteConcern(bw.writeConcern).CommandMonitor(bw.collection.client.monitor).
-		ServerSelector(bw.selector).ClusterClock(bw.collection.client.clock).
+		ServerSelector(bw.selector).Timeout(bw.collection.timeout).ClusterClock(bw.collection.client.clock).
 		Database(bw.collection.db.name).Collection(bw.collection.name).
-		Deployment(bw.collection.client.deployment).Crypt(bw.collection.client.crypt)
+		Deployment(bw.collection.client.deployment).Crypt(bw.collection.client.crypt).
+		SkipDocumentSizeValidation(!bw.collection.client.validateDocSize)
 	if bw.bypassDocumentValidation != nil && *bw.bypassDocumentValidation {
 		op = op.BypassDocumentValidation(*bw.bypassDocumentValidation)
 	}
@@ -193,9 +194,14 @@ func (bw *bulkWrite) runInsert(ctx context.Context, batch bulkWriteBatch) (opera
 	if bw.collection.client.retryWrites && batch.canRetry {
 		retry = driver.RetryOncePerCommand
 	}
-	op = op.Retry(retry)
+	op = op.Retry(retry).RetryTimeout(bw.collection.client.retryTimeout)
 
 	err := op.Execute(ctx)
+	if dtle, ok := err.(driver.DocumentTooLargeError); ok {
+		// Report the index of the model in the models passed to BulkWrite rather than its index in this batch.
+		dtle.Index = batch.indexes[dtle.Index]
+		err = dtle
+	}
 
 	return op.Result(), err
 }
@@ -228,7 +234,7 @@ func (bw *bulkWrite) runDelete(ctx context.Context, batch bulkWriteBatch) (opera
 
 	op := operation.NewDelete(docs...).
 		Session(bw.session).WriteConcern(bw.writeConcern).CommandMonitor(bw.collection.client.monitor).
-		ServerSelector(bw.selector).ClusterClock(bw.collection.client.clock).
+		ServerSelector(bw.selector).Timeout(bw.collection.timeout).ClusterClock(bw.collection.client.clock).
 		Database(bw.collection.db.name).Collection(bw.collection.name).
 		Deployment(bw.collection.client.deployment).Crypt(bw.collection.client.crypt).Hint(hasHint)
 	if bw.ordered != nil {
@@ -238,7 +244,7 @@ func (bw *bulkWrite) runDelete(ctx context.Context, batch bulkWriteBatch) (opera
 	if bw.collection.client.retryWrites && batch.canRetry {
 		retry = driver.RetryOncePerCommand
 	}
-	op = op.Retry(retry)
+	op = op.Retry(retry).RetryTimeout(bw.collection.client.retryTimeout)
 
 	err := op.Execute(ctx)
 
@@ -264,7 +270,7 @@ func createDeleteDoc(filter interface{}, collation *options.Collation, hint inte
 		doc = bsoncore.AppendDocumentElement(doc, "collation", collation.ToDocument())
 	}
 	if hint != nil {
-		hintVal, err := transformValue(registry, hint)
+		hintVal, err := transformHint(registry, hint)
 		if err != nil {
 			return nil, err
 		}
@@ -308,7 +314,7 @@ func (bw *bulkWrite) runUpdate(ctx context.Context, batch bulkWriteBatch) (opera
 
 	op := operation.NewUpdate(docs...).
 		Session(bw.session).WriteConcern(bw.writeConcern).CommandMonitor(bw.collection.client.monitor).
-		ServerSelector(bw.selector).ClusterClock(bw.collection.client.clock).
+		ServerSelector(bw.selector).Timeout(bw.collection.timeout).ClusterClock(bw.collection.client.clock).
 		Database(bw.collection.db.name).Collection(bw.collection.name).
 		Deployment(bw.collection.client.deployment).Crypt(bw.collection.client.crypt).Hint(hasHint).
 		ArrayFilters(hasArrayFilters)
@@ -322,7 +328,7 @@ func (bw *bulkWrite) runUpdate(ctx context.Context, batch bulkWriteBatch) (opera
 	if bw.collection.client.retryWrites && batch.canRetry {
 		retry = driver.RetryOncePerCommand
 	}
-	op = op.Retry(retry)
+	op = op.Retry(retry).RetryTimeout(bw.collection.client.retryTimeout)
 
 	err := op.Execute(ctx)
 
@@ -375,7 +381,7 @@ func createUpdateDoc(
 	}
 
 	if hint != nil {
-		hintVal, err := transformValue(registry, hint)
+		hintVal, err := transformHint(registry, hint)
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,66 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"sync"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/x/mongo/driver/session"
+)
+
+// causalClock records the latest operation time observed by the implicit sessions of a database that has causal
+// consistency enabled by default. Implicit sessions only live for a single operation, so the clock carries the
+// operation time from one operation to the next, which makes the implicit sessions behave like a single causally
+// consistent session. A nil *causalClock is valid and creates regular implicit sessions.
+type causalClock struct {
+	mu     sync.Mutex
+	opTime *primitive.Timestamp
+}
+
+// newImplicitSession creates an implicit session for an operation. If the clock is not nil, the session is causally
+// consistent and starts at the latest operation time recorded by the clock.
+func (cc *causalClock) newImplicitSession(client *Client) (*session.Client, error) {
+	if cc == nil {
+		return session.NewClientSession(client.sessionPool, client.id, session.Implicit)
+	}
+
+	consistent := true
+	sess, err := session.NewClientSession(client.sessionPool, client.id, session.Implicit,
+		&session.ClientOptions{CausalConsistency: &consistent})
+	if err != nil {
+		return nil, err
+	}
+
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	if cc.opTime != nil {
+		_ = sess.AdvanceOperationTime(cc.opTime)
+	}
+	return sess, nil
+}
+
+// advance records the operation time of an implicit session. Explicit sessions are ignored because their causal
+// consistency is controlled by the user.
+func (cc *causalClock) advance(sess *session.Client) {
+	if cc == nil || sess == nil || sess.SessionType != session.Implicit || sess.OperationTime == nil {
+		return
+	}
+
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	if cc.opTime == nil || primitive.CompareTimestamp(*sess.OperationTime, *cc.opTime) > 0 {
+		opTime := *sess.OperationTime
+		cc.opTime = &opTime
+	}
+}
+
+// endImplicitSession records the operation time of an implicit session and then ends it.
+func (cc *causalClock) endImplicitSession(sess *session.Client) {
+	cc.advance(sess)
+	sess.EndSession()
+}
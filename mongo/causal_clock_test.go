@@ -0,0 +1,76 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/internal/testutil/assert"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/x/mongo/driver/session"
+)
+
+func TestCausalClock(t *testing.T) {
+	client := setupClient()
+	client.sessionPool = session.NewPool(nil)
+
+	t.Run("database option", func(t *testing.T) {
+		db := client.Database("foo")
+		assert.Nil(t, db.causalClock, "expected no causal clock by default, got %v", db.causalClock)
+
+		opts := options.Database().SetCausalConsistencyDefault(true)
+		db1 := client.Database("foo", opts)
+		db2 := client.Database("foo", opts)
+		other := client.Database("bar", opts)
+		assert.NotNil(t, db1.causalClock, "expected causal clock to be set")
+		assert.True(t, db1.causalClock == db2.causalClock, "expected databases with the same name to share a clock")
+		assert.True(t, db1.causalClock != other.causalClock, "expected databases with different names to have different clocks")
+		coll := db1.Collection("coll")
+		assert.True(t, coll.db.causalClock == db1.causalClock, "expected collection to use the database clock")
+	})
+	t.Run("operation time is carried between implicit sessions", func(t *testing.T) {
+		cc := &causalClock{}
+		sess, err := cc.newImplicitSession(client)
+		assert.Nil(t, err, "newImplicitSession error: %v", err)
+		assert.True(t, sess.Consistent, "expected session to be causally consistent")
+		assert.Nil(t, sess.OperationTime, "expected no operation time, got %v", sess.OperationTime)
+
+		opTime := primitive.Timestamp{T: 10, I: 1}
+		_ = sess.AdvanceOperationTime(&opTime)
+		cc.endImplicitSession(sess)
+
+		sess, err = cc.newImplicitSession(client)
+		assert.Nil(t, err, "newImplicitSession error: %v", err)
+		defer sess.EndSession()
+		assert.NotNil(t, sess.OperationTime, "expected operation time to be set")
+		assert.Equal(t, opTime, *sess.OperationTime, "expected operation time %v, got %v", opTime, *sess.OperationTime)
+
+		// older operation times should not move the clock backwards
+		older, err := cc.newImplicitSession(client)
+		assert.Nil(t, err, "newImplicitSession error: %v", err)
+		older.OperationTime = &primitive.Timestamp{T: 5, I: 1}
+		cc.endImplicitSession(older)
+		assert.Equal(t, opTime, *cc.opTime, "expected clock time %v, got %v", opTime, *cc.opTime)
+	})
+	t.Run("explicit sessions are ignored", func(t *testing.T) {
+		cc := &causalClock{}
+		sess, err := session.NewClientSession(client.sessionPool, client.id, session.Explicit)
+		assert.Nil(t, err, "NewClientSession error: %v", err)
+		defer sess.EndSession()
+
+		sess.OperationTime = &primitive.Timestamp{T: 10, I: 1}
+		cc.advance(sess)
+		assert.Nil(t, cc.opTime, "expected clock time to not be set, got %v", cc.opTime)
+	})
+	t.Run("nil clock", func(t *testing.T) {
+		var cc *causalClock
+		sess, err := cc.newImplicitSession(client)
+		assert.Nil(t, err, "newImplicitSession error: %v", err)
+		cc.endImplicitSession(sess)
+	})
+}
@@ -83,6 +83,7 @@ type ChangeStream struct {
 	selector      description.ServerSelector
 	operationTime *primitive.Timestamp
 	wireVersion   *description.VersionRange
+	invalidated   bool // true if an invalidate event was returned and the stream should be re-opened
 }
 
 type changeStreamConfig struct {
@@ -94,6 +95,7 @@ type changeStreamConfig struct {
 	collectionName string
 	databaseName   string
 	crypt          *driver.Crypt
+	timeout        *time.Duration
 }
 
 func newChangeStream(ctx context.Context, config changeStreamConfig, pipeline interface{},
@@ -126,6 +128,7 @@ func newChangeStream(ctx context.Context, config changeStreamConfig, pipeline in
 		ReadPreference(config.readPreference).ReadConcern(config.readConcern).
 		Deployment(cs.client.deployment).ClusterClock(cs.client.clock).
 		CommandMonitor(cs.client.monitor).Session(cs.sess).ServerSelector(cs.selector).Retry(driver.RetryNone).
+		Timeout(config.timeout).
 		Crypt(config.crypt)
 
 	if config.crypt != nil {
@@ -134,6 +137,15 @@ func newChangeStream(ctx context.Context, config changeStreamConfig, pipeline in
 	if cs.options.Collation != nil {
 		cs.aggregate.Collation(bsoncore.Document(cs.options.Collation.ToDocument()))
 	}
+	if cs.options.Comment != nil {
+		var commentVal bsoncore.Value
+		if commentVal, cs.err = transformValue(cs.registry, cs.options.Comment); cs.err != nil {
+			closeImplicitSession(cs.sess)
+			return nil, cs.Err()
+		}
+		cs.aggregate.CommentValue(commentVal)
+		cs.cursorOptions.Comment = commentVal
+	}
 	if cs.options.BatchSize != nil {
 		cs.aggregate.BatchSize(*cs.options.BatchSize)
 		cs.cursorOptions.BatchSize = *cs.options.BatchSize
@@ -212,20 +224,9 @@ func (cs *ChangeStream) executeOperation(ctx context.Context, resuming bool) err
 
 	if resuming {
 		cs.replaceOptions(ctx, cs.wireVersion)
-
-		csOptDoc := cs.createPipelineOptionsDoc()
-		pipIdx, pipDoc := bsoncore.AppendDocumentStart(nil)
-		pipDoc = bsoncore.AppendDocumentElement(pipDoc, "$changeStream", csOptDoc)
-		if pipDoc, cs.err = bsoncore.AppendDocumentEnd(pipDoc, pipIdx); cs.err != nil {
-			return cs.Err()
-		}
-		cs.pipelineSlice[0] = pipDoc
-
-		var plArr bsoncore.Document
-		if plArr, cs.err = cs.pipelineToBSON(); cs.err != nil {
+		if cs.err = cs.updatePipeline(); cs.err != nil {
 			return cs.Err()
 		}
-		cs.aggregate.Pipeline(plArr)
 	}
 
 	if original := cs.aggregate.Execute(ctx); original != nil {
@@ -289,6 +290,41 @@ func (cs *ChangeStream) executeOperation(ctx context.Context, resuming bool) err
 	return cs.Err()
 }
 
+// updatePipeline rebuilds the $changeStream stage from the current options and sets the new pipeline on the aggregate
+// operation.
+func (cs *ChangeStream) updatePipeline() error {
+	csOptDoc := cs.createPipelineOptionsDoc()
+	pipIdx, pipDoc := bsoncore.AppendDocumentStart(nil)
+	pipDoc = bsoncore.AppendDocumentElement(pipDoc, "$changeStream", csOptDoc)
+	pipDoc, err := bsoncore.AppendDocumentEnd(pipDoc, pipIdx)
+	if err != nil {
+		return err
+	}
+	cs.pipelineSlice[0] = pipDoc
+
+	plArr, err := cs.pipelineToBSON()
+	if err != nil {
+		return err
+	}
+	cs.aggregate.Pipeline(plArr)
+	return nil
+}
+
+// reopen starts a new change stream after an invalidate event, using the invalidate event's resume token as the
+// startAfter option.
+func (cs *ChangeStream) reopen(ctx context.Context) error {
+	cs.invalidated = false
+	_ = cs.cursor.Close(ctx)
+
+	cs.options.SetStartAfter(cs.resumeToken)
+	cs.options.SetResumeAfter(nil)
+	cs.options.SetStartAtOperationTime(nil)
+	if cs.err = cs.updatePipeline(); cs.err != nil {
+		return cs.Err()
+	}
+	return cs.executeOperation(ctx, false)
+}
+
 // Updates the post batch resume token after a successful aggregate or getMore operation.
 func (cs *ChangeStream) updatePbrtFromCommand() {
 	// Only cache the pbrt if an empty batch was returned and a pbrt was included
@@ -543,6 +579,11 @@ func (cs *ChangeStream) next(ctx context.Context, nonBlocking bool) bool {
 	if cs.err = cs.storeResumeToken(); cs.err != nil {
 		return false
 	}
+	if cs.options.ReopenOnInvalidate != nil && *cs.options.ReopenOnInvalidate &&
+		cs.wireVersion != nil && cs.wireVersion.Max >= 8 {
+		opType, _ := cs.Current.Lookup("operationType").StringValueOK()
+		cs.invalidated = opType == "invalidate"
+	}
 	return true
 }
 
@@ -562,7 +603,13 @@ func (cs *ChangeStream) loopNext(ctx context.Context, nonBlocking bool) {
 		if cs.err == nil {
 			// Check if cursor is alive
 			if cs.ID() == 0 {
-				return
+				if !cs.invalidated {
+					return
+				}
+				if cs.err = cs.reopen(ctx); cs.err != nil {
+					return
+				}
+				continue
 			}
 
 			// If a getMore was done but the batch was empty, the batch cursor will return false with no error.
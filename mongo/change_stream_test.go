@@ -7,11 +7,53 @@
 package mongo
 
 import (
+	"context"
+	"errors"
 	"testing"
+	"time"
 
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/internal/testutil/assert"
+	"go.mongodb.org/mongo-driver/mongo/description"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/x/mongo/driver"
+	"go.mongodb.org/mongo-driver/x/mongo/driver/drivertest"
 )
 
+var errDeadlineRecorded = errors.New("deadline recorded")
+
+// deadlineConnection is a driver.Connection that records the deadline of the context used to write a wire message and
+// fails the write.
+type deadlineConnection struct {
+	*drivertest.ChannelConn
+	deadline    *time.Time
+	hasDeadline *bool
+}
+
+func (dc deadlineConnection) WriteWireMessage(ctx context.Context, _ []byte) error {
+	*dc.deadline, *dc.hasDeadline = ctx.Deadline()
+	return errDeadlineRecorded
+}
+
+// deadlineServer is a driver.Server that returns a deadlineConnection.
+type deadlineServer struct {
+	conn deadlineConnection
+}
+
+func (ds deadlineServer) Connection(context.Context) (driver.Connection, error) {
+	return ds.conn, nil
+}
+
+// deadlineDeployment is a mockDeployment that selects a deadlineServer.
+type deadlineDeployment struct {
+	mockDeployment
+	server deadlineServer
+}
+
+func (dd deadlineDeployment) SelectServer(context.Context, description.ServerSelector) (driver.Server, error) {
+	return dd.server, nil
+}
+
 func TestChangeStream(t *testing.T) {
 	t.Run("nil cursor", func(t *testing.T) {
 		cs := &ChangeStream{}
@@ -26,4 +68,40 @@ func TestChangeStream(t *testing.T) {
 		err = cs.Close(bgCtx)
 		assert.Nil(t, err, "Close error: %v", err)
 	})
+	t.Run("default timeout", func(t *testing.T) {
+		var deadline time.Time
+		var hasDeadline bool
+		conn := deadlineConnection{
+			ChannelConn: &drivertest.ChannelConn{},
+			deadline:    &deadline,
+			hasDeadline: &hasDeadline,
+		}
+		client := setupClient(&options.ClientOptions{Deployment: deadlineDeployment{server: deadlineServer{conn: conn}}})
+		db := client.Database("foo", options.Database().SetDefaultTimeout(time.Hour))
+
+		testCases := []struct {
+			name    string
+			watch   func() (*ChangeStream, error)
+			timeout time.Duration
+		}{
+			{"database", func() (*ChangeStream, error) {
+				return db.Watch(bgCtx, bson.A{})
+			}, time.Hour},
+			{"collection", func() (*ChangeStream, error) {
+				coll := db.Collection("bar", options.Collection().SetDefaultTimeout(2*time.Hour))
+				return coll.Watch(bgCtx, bson.A{})
+			}, 2 * time.Hour},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				start := time.Now()
+				_, err := tc.watch()
+				assert.NotNil(t, err, "expected Watch error, got nil")
+				assert.True(t, hasDeadline, "expected the aggregate to have a deadline")
+				want := start.Add(tc.timeout)
+				assert.True(t, !deadline.Before(want) && deadline.Before(want.Add(time.Minute)),
+					"expected deadline around %v, got %v", want, deadline)
+			})
+		}
+	})
 }
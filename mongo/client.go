@@ -10,7 +10,10 @@ import (
 	"context"
 	"crypto/tls"
 	"errors"
+	"fmt"
+	"net/url"
 	"strings"
+	"sync"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
@@ -55,6 +58,10 @@ type Client struct {
 	localThreshold  time.Duration
 	retryWrites     bool
 	retryReads      bool
+	validateDocSize bool
+	timeout         *time.Duration
+	retryTimeout    *time.Duration
+	waitForConn     *time.Duration
 	clock           *session.ClusterClock
 	readPreference  *readpref.ReadPref
 	readConcern     *readconcern.ReadConcern
@@ -64,6 +71,12 @@ type Client struct {
 	monitor         *event.CommandMonitor
 	serverMonitor   *event.ServerMonitor
 	sessionPool     *session.Pool
+	operations      *operationRegistry
+
+	// causalClocks holds the causal clocks for databases that have causal consistency enabled by default, keyed by
+	// database name.
+	causalClocks     map[string]*causalClock
+	causalClocksLock sync.Mutex
 
 	// client-side encryption fields
 	keyVaultClient *Client
@@ -146,7 +159,9 @@ func NewClient(opts ...*options.ClientOptions) (*Client, error) {
 // If the Client was created using the NewClient function, this method must be called before a Client can be used.
 //
 // Connect starts background goroutines to monitor the state of the deployment and does not do any I/O in the main
-// goroutine. The Client.Ping method can be used to verify that the connection was created successfully.
+// goroutine. The Client.Ping method can be used to verify that the connection was created successfully. If the
+// ClientOptions.SetWaitForConnection option was set, Connect also waits for a writable server to be discovered; see
+// Client.Ready. If none is discovered in time, the Client is disconnected before Connect returns the error.
 func (c *Client) Connect(ctx context.Context) error {
 	if connector, ok := c.deployment.(driver.Connector); ok {
 		err := connector.Connect()
@@ -175,6 +190,19 @@ func (c *Client) Connect(ctx context.Context) error {
 		updateChan = sub.Updates
 	}
 	c.sessionPool = session.NewPool(updateChan)
+
+	if c.waitForConn != nil {
+		if ctx == nil {
+			ctx = context.Background()
+		}
+		readyCtx, cancel := context.WithTimeout(ctx, *c.waitForConn)
+		defer cancel()
+		if err := c.Ready(readyCtx); err != nil {
+			// Stop the monitoring started above, since the caller does not get a usable Client to disconnect.
+			_ = c.Disconnect(ctx)
+			return err
+		}
+	}
 	return nil
 }
 
@@ -240,6 +268,46 @@ func (c *Client) Ping(ctx context.Context, rp *readpref.ReadPref) error {
 	return replaceErrors(res.Err())
 }
 
+// Ready blocks until the client has discovered a writable server or ctx expires. Unlike Ping, Ready does not send any
+// commands; it only waits for server monitoring to report a server that can accept writes (e.g. a replica set primary,
+// a mongos, or a standalone). If ctx expires first, the returned error includes the last observed topology
+// description so the cause of the failure (e.g. no primary, unreachable servers) can be diagnosed.
+//
+// Ready returns nil immediately if the client's deployment does not report topology changes.
+func (c *Client) Ready(ctx context.Context) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	subscriber, ok := c.deployment.(driver.Subscriber)
+	if !ok {
+		return nil
+	}
+	sub, err := subscriber.Subscribe()
+	if err != nil {
+		return replaceErrors(err)
+	}
+	defer func() {
+		_ = subscriber.Unsubscribe(sub)
+	}()
+
+	var desc description.Topology
+	for {
+		select {
+		case td, ok := <-sub.Updates:
+			if !ok {
+				return ErrClientDisconnected
+			}
+			desc = td
+			if desc.HasWritableServer() {
+				return nil
+			}
+		case <-ctx.Done():
+			return fmt.Errorf("no writable server available: %v; topology: %v", ctx.Err(), desc)
+		}
+	}
+}
+
 // StartSession starts a new session configured with the given options.
 //
 // If the DefaultReadConcern, DefaultWriteConcern, or DefaultReadPreference options are not set, the client's read
@@ -326,8 +394,21 @@ func (c *Client) configure(opts *options.ClientOptions) error {
 
 	// TODO(GODRIVER-814): Add tests for topology, server, and connection related options.
 
+	// Operation tracking. Operations are tracked by the topology, so they are not tracked for custom deployments.
+	c.operations = newOperationRegistry()
+	if opts.Deployment == nil {
+		topologyOpts = append(topologyOpts, topology.WithOperationTracker(
+			func(func(context.Context) (context.Context, func())) func(context.Context) (context.Context, func()) {
+				return c.operations.track
+			},
+		))
+	}
+
 	// ClusterClock
 	c.clock = new(session.ClusterClock)
+	if opts.ClusterTimeObserver != nil {
+		c.clock.SetObserver(opts.ClusterTimeObserver)
+	}
 
 	// Pass down URI so topology can determine whether or not SRV polling is required
 	topologyOpts = append(topologyOpts, topology.WithURI(func(uri string) string {
@@ -362,6 +443,10 @@ func (c *Client) configure(opts *options.ClientOptions) error {
 				}))
 			case "zstd":
 				connOpts = append(connOpts, topology.WithZstdLevel(func(level *int) *int {
+					// -1 selects the default level, as it does for the "zstdCompressionLevel" URI option.
+					if opts.ZstdLevel != nil && *opts.ZstdLevel == -1 {
+						return nil
+					}
 					return opts.ZstdLevel
 				}))
 			}
@@ -437,6 +522,16 @@ func (c *Client) configure(opts *options.ClientOptions) error {
 			func(topology.Dialer) topology.Dialer { return opts.Dialer },
 		))
 	}
+	// ProxyURL
+	if opts.ProxyURL != nil {
+		proxyURL, err := url.Parse(*opts.ProxyURL)
+		if err != nil {
+			return err
+		}
+		connOpts = append(connOpts, topology.WithProxyURL(
+			func(*url.URL) *url.URL { return proxyURL },
+		))
+	}
 	// Direct
 	if opts.Direct != nil && *opts.Direct {
 		topologyOpts = append(topologyOpts, topology.WithMode(
@@ -475,6 +570,19 @@ func (c *Client) configure(opts *options.ClientOptions) error {
 			topology.WithMaxConnections(func(uint64) uint64 { return *opts.MaxPoolSize }),
 		)
 	}
+	// MaxPoolSizePerServerKind
+	if len(opts.MaxPoolSizePerServerKind) > 0 {
+		maxPoolSizes := make(map[description.ServerKind]uint64, len(opts.MaxPoolSizePerServerKind))
+		for kind, size := range opts.MaxPoolSizePerServerKind {
+			maxPoolSizes[kind] = size
+		}
+		serverOpts = append(
+			serverOpts,
+			topology.WithMaxConnectionsPerServerKind(
+				func(map[description.ServerKind]uint64) map[description.ServerKind]uint64 { return maxPoolSizes },
+			),
+		)
+	}
 	// MinPoolSize
 	if opts.MinPoolSize != nil {
 		serverOpts = append(
@@ -482,31 +590,44 @@ func (c *Client) configure(opts *options.ClientOptions) error {
 			topology.WithMinConnections(func(uint64) uint64 { return *opts.MinPoolSize }),
 		)
 	}
+	poolMonitor, monitor, serverMonitor := opts.PoolMonitor, opts.Monitor, opts.ServerMonitor
+	// LoggerOptions
+	if opts.LoggerOptions != nil {
+		l := newLogger(opts.LoggerOptions)
+		poolMonitor = l.poolMonitor(poolMonitor)
+		monitor = l.commandMonitor(monitor)
+		serverMonitor = l.serverMonitor(serverMonitor)
+		if l.enabled(options.LogComponentServerSelection, options.LogLevelInfo) {
+			topologyOpts = append(topologyOpts, topology.WithServerSelectionHook(
+				func(func(description.Server, error)) func(description.Server, error) { return l.serverSelected },
+			))
+		}
+	}
 	// PoolMonitor
-	if opts.PoolMonitor != nil {
+	if poolMonitor != nil {
 		serverOpts = append(
 			serverOpts,
-			topology.WithConnectionPoolMonitor(func(*event.PoolMonitor) *event.PoolMonitor { return opts.PoolMonitor }),
+			topology.WithConnectionPoolMonitor(func(*event.PoolMonitor) *event.PoolMonitor { return poolMonitor }),
 		)
 	}
 	// Monitor
-	if opts.Monitor != nil {
-		c.monitor = opts.Monitor
+	if monitor != nil {
+		c.monitor = monitor
 		connOpts = append(connOpts, topology.WithMonitor(
-			func(*event.CommandMonitor) *event.CommandMonitor { return opts.Monitor },
+			func(*event.CommandMonitor) *event.CommandMonitor { return monitor },
 		))
 	}
 	// ServerMonitor
-	if opts.ServerMonitor != nil {
-		c.serverMonitor = opts.ServerMonitor
+	if serverMonitor != nil {
+		c.serverMonitor = serverMonitor
 		serverOpts = append(
 			serverOpts,
-			topology.WithServerMonitor(func(*event.ServerMonitor) *event.ServerMonitor { return opts.ServerMonitor }),
+			topology.WithServerMonitor(func(*event.ServerMonitor) *event.ServerMonitor { return serverMonitor }),
 		)
 
 		topologyOpts = append(
 			topologyOpts,
-			topology.WithTopologyServerMonitor(func(*event.ServerMonitor) *event.ServerMonitor { return opts.ServerMonitor }),
+			topology.WithTopologyServerMonitor(func(*event.ServerMonitor) *event.ServerMonitor { return serverMonitor }),
 		)
 	}
 	// ReadConcern
@@ -524,6 +645,12 @@ func (c *Client) configure(opts *options.ClientOptions) error {
 	if opts.Registry != nil {
 		c.registry = opts.Registry
 	}
+	// RejectServerSideJS
+	if opts.RejectServerSideJS != nil && *opts.RejectServerSideJS {
+		topologyOpts = append(topologyOpts, topology.WithCommandValidator(
+			func(func(bsoncore.Document) error) func(bsoncore.Document) error { return rejectServerSideJavaScript },
+		))
+	}
 	// ReplicaSet
 	if opts.ReplicaSet != nil {
 		topologyOpts = append(topologyOpts, topology.WithReplicaSetName(
@@ -535,18 +662,40 @@ func (c *Client) configure(opts *options.ClientOptions) error {
 	if opts.RetryWrites != nil {
 		c.retryWrites = *opts.RetryWrites
 	}
+	c.retryTimeout = opts.RetryWritesTimeout
+	c.waitForConn = opts.WaitForConnection
 	c.retryReads = true
 	if opts.RetryReads != nil {
 		c.retryReads = *opts.RetryReads
 	}
+	// ValidateDocumentSize
+	c.validateDocSize = true
+	if opts.ValidateDocumentSize != nil {
+		c.validateDocSize = *opts.ValidateDocumentSize
+	}
+	// SeedListOrder
+	if opts.SeedListOrder != nil {
+		topologyOpts = append(topologyOpts, topology.WithSeedListShuffle(
+			func(bool) bool { return *opts.SeedListOrder == options.Randomized },
+		))
+	}
+	// FailFastWhenNoServer. Fail fast is implemented by the topology's server selection, so it does not apply to custom
+	// deployments.
+	if opts.FailFastWhenNoServer != nil && opts.Deployment == nil {
+		topologyOpts = append(topologyOpts, topology.WithFailFast(
+			func(bool) bool { return *opts.FailFastWhenNoServer },
+		))
+	}
 	// ServerSelectionTimeout
 	if opts.ServerSelectionTimeout != nil {
 		topologyOpts = append(topologyOpts, topology.WithServerSelectionTimeout(
 			func(time.Duration) time.Duration { return *opts.ServerSelectionTimeout },
 		))
 	}
-	// SocketTimeout
-	if opts.SocketTimeout != nil {
+	// Timeout
+	c.timeout = opts.Timeout
+	// SocketTimeout is ignored if Timeout is set so that the operation deadline governs socket reads and writes.
+	if opts.SocketTimeout != nil && opts.Timeout == nil {
 		connOpts = append(
 			connOpts,
 			topology.WithReadTimeout(func(time.Duration) time.Duration { return *opts.SocketTimeout }),
@@ -689,6 +838,23 @@ func (c *Client) Database(name string, opts ...*options.DatabaseOptions) *Databa
 	return newDatabase(c, name, opts...)
 }
 
+// causalClock returns the causal clock for the database with the given name, creating it if necessary. All Database
+// instances for the same database share a clock.
+func (c *Client) causalClock(dbName string) *causalClock {
+	c.causalClocksLock.Lock()
+	defer c.causalClocksLock.Unlock()
+
+	if c.causalClocks == nil {
+		c.causalClocks = make(map[string]*causalClock)
+	}
+	cc, ok := c.causalClocks[dbName]
+	if !ok {
+		cc = &causalClock{}
+		c.causalClocks[dbName] = cc
+	}
+	return cc
+}
+
 // ListDatabases executes a listDatabases command and returns the result.
 //
 // The filter parameter must be a document containing query operators and can be used to select which
@@ -733,7 +899,8 @@ func (c *Client) ListDatabases(ctx context.Context, filter interface{}, opts ...
 	ldo := options.MergeListDatabasesOptions(opts...)
 	op := operation.NewListDatabases(filterDoc).
 		Session(sess).ReadPreference(c.readPreference).CommandMonitor(c.monitor).
-		ServerSelector(selector).ClusterClock(c.clock).Database("admin").Deployment(c.deployment).Crypt(c.crypt)
+		ServerSelector(selector).ClusterClock(c.clock).Database("admin").Deployment(c.deployment).Crypt(c.crypt).
+		Timeout(c.timeout)
 
 	if ldo.NameOnly != nil {
 		op = op.NameOnly(*ldo.NameOnly)
@@ -757,7 +924,8 @@ func (c *Client) ListDatabases(ctx context.Context, filter interface{}, opts ...
 }
 
 // ListDatabaseNames executes a listDatabases command and returns a slice containing the names of all of the databases
-// on the server.
+// on the server. The command is always sent with nameOnly set to true so the server does not compute the size of
+// each database. The AuthorizedDatabases option is honored as it is for ListDatabases.
 //
 // The filter parameter must be a document containing query operators and can be used to select which databases
 // are included in the result. It cannot be nil. An empty document (e.g. bson.D{}) should be used to include all
@@ -843,13 +1011,49 @@ func (c *Client) Watch(ctx context.Context, pipeline interface{},
 		registry:       c.registry,
 		streamType:     ClientStream,
 		crypt:          c.crypt,
+		timeout:        c.timeout,
 	}
 
 	return newChangeStream(ctx, csConfig, pipeline, opts...)
 }
 
+// InFlightOperations returns the number of operations that are currently executing on this client. Operations are
+// counted from the time they start selecting a server until their result is available. Iterating a cursor or change
+// stream is not counted.
+func (c *Client) InFlightOperations() int {
+	if c.operations == nil {
+		return 0
+	}
+	return c.operations.len()
+}
+
+// CancelAll cancels the contexts of all operations that are currently executing on this client, causing them to return
+// a context cancellation error. Operations started after CancelAll returns are not affected.
+func (c *Client) CancelAll() {
+	if c.operations == nil {
+		return
+	}
+	c.operations.cancelAll()
+}
+
 // NumberSessionsInProgress returns the number of sessions that have been started for this client but have not been
 // closed (i.e. EndSession has not been called).
 func (c *Client) NumberSessionsInProgress() int {
 	return c.sessionPool.CheckedOut()
 }
+
+// TopologyChangesSince returns the servers that have been added to or removed from the deployment since previous was
+// observed, along with the current topology description. Both values are computed from a single snapshot, so passing
+// the returned description as previous on the next call will not miss or repeat any change. Passing an empty
+// description.Topology reports every current server as added.
+//
+// If the Client was created with a custom deployment that does not provide a topology description, the returned
+// description is empty and the diff reports every server in previous as removed.
+func (c *Client) TopologyChangesSince(previous description.Topology) (description.TopologyDiff, description.Topology) {
+	var current description.Topology
+	if describer, ok := c.deployment.(interface{ Description() description.Topology }); ok {
+		// Clone the description so callers cannot modify the topology's view of the deployment.
+		current = describer.Description().Clone()
+	}
+	return description.DiffTopology(previous, current), current
+}
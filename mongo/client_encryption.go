@@ -88,7 +88,63 @@ func (ce *ClientEncryption) CreateDataKey(ctx context.Context, kmsProvider strin
 	return primitive.Binary{Subtype: subtype, Data: data}, nil
 }
 
+// CreateEncryptedCollection creates a new collection for Queryable Encryption with the help of automatic generation of
+// new encryption data keys for null keyIds. createOpts must set EncryptedFields. Each entry of its "fields" array with
+// a null or missing keyId is given a new data key created with the given kmsProvider and masterKey. Fields with "range"
+// or "rangePreview" queries are supported the same way as "equality" queries.
+//
+// It returns the created collection and the encryptedFields document with the generated keyIds. If creating a data key
+// fails, the encryptedFields document with the keyIds created so far is returned along with the error.
+func (ce *ClientEncryption) CreateEncryptedCollection(ctx context.Context, db *Database, coll string,
+	createOpts *options.CreateCollectionOptions, kmsProvider string, masterKey interface{}) (*Collection, bson.M, error) {
+
+	if createOpts == nil || createOpts.EncryptedFields == nil {
+		return nil, nil, errors.New("no EncryptedFields defined for the collection")
+	}
+
+	efDoc, err := transformBsoncoreDocument(db.registry, createOpts.EncryptedFields)
+	if err != nil {
+		return nil, nil, err
+	}
+	var ef bson.M
+	if err = bson.UnmarshalWithRegistry(db.registry, efDoc, &ef); err != nil {
+		return nil, nil, err
+	}
+
+	if fields, ok := ef["fields"].(bson.A); ok {
+		for _, field := range fields {
+			f, ok := field.(bson.M)
+			if !ok {
+				continue
+			}
+			if keyID, ok := f["keyId"]; ok && keyID != nil {
+				continue
+			}
+
+			dko := options.DataKey()
+			if masterKey != nil {
+				dko.SetMasterKey(masterKey)
+			}
+			keyID, err := ce.CreateDataKey(ctx, kmsProvider, dko)
+			if err != nil {
+				return nil, ef, err
+			}
+			f["keyId"] = keyID
+		}
+	}
+
+	// Copy the options so the caller's EncryptedFields is not replaced.
+	cco := *createOpts
+	cco.EncryptedFields = ef
+	if err = db.CreateCollection(ctx, coll, &cco); err != nil {
+		return nil, ef, err
+	}
+	return db.Collection(coll), ef, nil
+}
+
 // Encrypt encrypts a BSON value with the given key and algorithm. Returns an encrypted value (BSON binary of subtype 6).
+//
+// The "Indexed", "Range" and "RangePreview" algorithms require libmongocrypt 1.8.0 or later.
 func (ce *ClientEncryption) Encrypt(ctx context.Context, val bson.RawValue, opts ...*options.EncryptOptions) (primitive.Binary, error) {
 	eo := options.MergeEncryptOptions(opts...)
 	transformed := cryptOpts.ExplicitEncryption()
@@ -99,6 +155,24 @@ func (ce *ClientEncryption) Encrypt(ctx context.Context, val bson.RawValue, opts
 		transformed.SetKeyAltName(*eo.KeyAltName)
 	}
 	transformed.SetAlgorithm(eo.Algorithm)
+	if eo.QueryType != "" {
+		transformed.SetQueryType(eo.QueryType)
+	}
+	if eo.ContentionFactor != nil {
+		transformed.SetContentionFactor(*eo.ContentionFactor)
+	}
+	if eo.RangeOptions != nil {
+		var ro cryptOpts.ExplicitRangeOptions
+		if eo.RangeOptions.Min != nil {
+			ro.Min = &bsoncore.Value{Type: eo.RangeOptions.Min.Type, Data: eo.RangeOptions.Min.Value}
+		}
+		if eo.RangeOptions.Max != nil {
+			ro.Max = &bsoncore.Value{Type: eo.RangeOptions.Max.Type, Data: eo.RangeOptions.Max.Value}
+		}
+		ro.Sparsity = eo.RangeOptions.Sparsity
+		ro.Precision = eo.RangeOptions.Precision
+		transformed.SetRangeOptions(ro)
+	}
 
 	subtype, data, err := ce.crypt.EncryptExplicit(ctx, bsoncore.Value{Type: val.Type, Data: val.Value}, transformed)
 	if err != nil {
@@ -0,0 +1,142 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo/address"
+	"go.mongodb.org/mongo-driver/mongo/description"
+	"go.mongodb.org/mongo-driver/x/mongo/driver/topology"
+)
+
+// ClientStats is a snapshot of the connection pools and the deployment topology of a Client.
+type ClientStats struct {
+	// Pools contains the statistics for the connection pool of each known server, keyed by server address.
+	Pools map[string]PoolStats
+	// Topology contains the statistics for the deployment topology.
+	Topology TopologyStats
+}
+
+// PoolStats is a snapshot of the connections in the connection pool for a single server.
+type PoolStats struct {
+	// InUse is the number of open connections that are checked out of the pool.
+	InUse int
+	// Available is the number of open connections that are idle in the pool.
+	Available int
+	// PendingConnections is the number of connections that have been created but have not finished connecting yet.
+	// Pending connections are also counted in InUse or Available.
+	PendingConnections int
+	// TotalCreated is the number of connections created by the pool since the Client was connected.
+	TotalCreated uint64
+}
+
+// ConnStat is a snapshot of the state of a single connection in a connection pool.
+type ConnStat struct {
+	// ID is the pool-assigned ID of the connection. It matches the ConnectionID reported in event.PoolEvent.
+	ID uint64
+	// CreatedAt is the time at which the connection was created.
+	CreatedAt time.Time
+	// LastUsed is the time at which the connection was last checked out of or returned to the pool. It is the zero
+	// time if the connection has never been checked out.
+	LastUsed time.Time
+	// InUse is true if the connection is currently checked out of the pool.
+	InUse bool
+	// Generation is the pool generation the connection was created in. The generation is incremented when the pool
+	// is cleared, e.g. after a network error or a failover, and connections from older generations are closed.
+	Generation uint64
+}
+
+// TopologyStats is a snapshot of the deployment topology as seen by a Client.
+type TopologyStats struct {
+	// ServerCount is the number of servers known to the Client, regardless of their state.
+	ServerCount int
+	// HasPrimary is true if a writable server (a replica set primary, a standalone, or a mongos) is known.
+	HasPrimary bool
+	// SecondaryCount is the number of known replica set secondaries.
+	SecondaryCount int
+	// AverageRTT is the mean of the average round trip times of the servers that have been successfully checked. It
+	// is zero if no server has been checked yet.
+	AverageRTT time.Duration
+}
+
+// Stats returns a snapshot of the connection pool and topology statistics for the Client. This is intended for use by
+// metrics exporters. If the Client has not been connected, the returned ClientStats will not contain any pools or
+// servers.
+func (c *Client) Stats() ClientStats {
+	stats := ClientStats{Pools: make(map[string]PoolStats)}
+
+	if poolStatser, ok := c.deployment.(interface {
+		PoolStats() map[address.Address]topology.PoolStats
+	}); ok {
+		for addr, ps := range poolStatser.PoolStats() {
+			stats.Pools[addr.String()] = PoolStats{
+				InUse:              ps.InUse,
+				Available:          ps.Available,
+				PendingConnections: ps.PendingConnections,
+				TotalCreated:       ps.TotalCreated,
+			}
+		}
+	}
+
+	if describer, ok := c.deployment.(interface{ Description() description.Topology }); ok {
+		stats.Topology = newTopologyStats(describer.Description())
+	}
+	return stats
+}
+
+// ConnectionStats returns a snapshot of each open connection in the connection pool for the server at addr, ordered by
+// connection ID. This is intended for diagnosing connection leaks and pool churn. An error is returned if the Client is
+// not connected to a server at addr.
+func (c *Client) ConnectionStats(addr address.Address) ([]ConnStat, error) {
+	connStatser, ok := c.deployment.(interface {
+		ConnectionStats(address.Address) ([]topology.ConnectionStats, error)
+	})
+	if !ok {
+		return nil, errors.New("deployment does not support connection stats")
+	}
+	conns, err := connStatser.ConnectionStats(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := make([]ConnStat, 0, len(conns))
+	for _, cs := range conns {
+		stats = append(stats, ConnStat{
+			ID:         cs.ID,
+			CreatedAt:  cs.CreatedAt,
+			LastUsed:   cs.LastUsed,
+			InUse:      cs.InUse,
+			Generation: cs.Generation,
+		})
+	}
+	return stats, nil
+}
+
+func newTopologyStats(desc description.Topology) TopologyStats {
+	ts := TopologyStats{ServerCount: len(desc.Servers)}
+
+	var totalRTT time.Duration
+	var rttCount int
+	for _, server := range desc.Servers {
+		switch server.Kind {
+		case description.RSPrimary, description.Standalone, description.Mongos:
+			ts.HasPrimary = true
+		case description.RSSecondary:
+			ts.SecondaryCount++
+		}
+		if server.AverageRTTSet {
+			totalRTT += server.AverageRTT
+			rttCount++
+		}
+	}
+	if rttCount > 0 {
+		ts.AverageRTT = totalRTT / time.Duration(rttCount)
+	}
+	return ts
+}
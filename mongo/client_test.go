@@ -10,6 +10,7 @@ import (
 	"context"
 	"errors"
 	"math"
+	"strings"
 	"testing"
 	"time"
 
@@ -17,6 +18,7 @@ import (
 	"go.mongodb.org/mongo-driver/event"
 	"go.mongodb.org/mongo-driver/internal/testutil"
 	"go.mongodb.org/mongo-driver/internal/testutil/assert"
+	"go.mongodb.org/mongo-driver/mongo/address"
 	"go.mongodb.org/mongo-driver/mongo/description"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.mongodb.org/mongo-driver/mongo/readconcern"
@@ -47,6 +49,45 @@ func (md mockDeployment) Kind() description.TopologyKind {
 	return description.Single
 }
 
+// describedDeployment is a mockDeployment that also reports a topology description.
+type describedDeployment struct {
+	mockDeployment
+	desc description.Topology
+}
+
+func (dd describedDeployment) Description() description.Topology {
+	return dd.desc
+}
+
+// subscribedDeployment is a mockDeployment that sends the given topology descriptions to each new subscription.
+type subscribedDeployment struct {
+	mockDeployment
+	updates []description.Topology
+}
+
+func (sd subscribedDeployment) Subscribe() (*driver.Subscription, error) {
+	ch := make(chan description.Topology, len(sd.updates))
+	for _, td := range sd.updates {
+		ch <- td
+	}
+	return &driver.Subscription{Updates: ch}, nil
+}
+
+func (sd subscribedDeployment) Unsubscribe(*driver.Subscription) error {
+	return nil
+}
+
+// disconnectedDeployment is a subscribedDeployment that records whether it was disconnected.
+type disconnectedDeployment struct {
+	subscribedDeployment
+	disconnected *bool
+}
+
+func (dd disconnectedDeployment) Disconnect(context.Context) error {
+	*dd.disconnected = true
+	return nil
+}
+
 func TestClient(t *testing.T) {
 	t.Run("new client", func(t *testing.T) {
 		client := setupClient()
@@ -136,6 +177,11 @@ func TestClient(t *testing.T) {
 			_, ok := client.deployment.(mockDeployment)
 			assert.True(t, ok, "expected deployment type %T, got %T", mockDeployment{}, client.deployment)
 		})
+		t.Run("fail fast is ignored", func(t *testing.T) {
+			opts := &options.ClientOptions{Deployment: mockDeployment{}}
+			_, err := NewClient(opts.SetFailFastWhenNoServer(true))
+			assert.Nil(t, err, "NewClient error: %v", err)
+		})
 		t.Run("error", func(t *testing.T) {
 			errmsg := "cannot specify topology or server options with a deployment"
 
@@ -155,6 +201,28 @@ func TestClient(t *testing.T) {
 			})
 		})
 	})
+	t.Run("in-flight operations", func(t *testing.T) {
+		client := setupClient()
+		ctx, done := client.operations.track(bgCtx)
+		assert.Equal(t, 1, client.InFlightOperations(), "expected 1 in-flight operation, got %v",
+			client.InFlightOperations())
+
+		client.CancelAll()
+		assert.Equal(t, context.Canceled, ctx.Err(), "expected context error %v, got %v", context.Canceled, ctx.Err())
+		done()
+		assert.Equal(t, 0, client.InFlightOperations(), "expected 0 in-flight operations, got %v",
+			client.InFlightOperations())
+
+		// Clients with custom deployments do not track operations but must still support these methods.
+		client = setupClient(&options.ClientOptions{Deployment: mockDeployment{}})
+		assert.Equal(t, 0, client.InFlightOperations(), "expected 0 in-flight operations, got %v",
+			client.InFlightOperations())
+		client.CancelAll()
+		client = &Client{}
+		assert.Equal(t, 0, client.InFlightOperations(), "expected 0 in-flight operations, got %v",
+			client.InFlightOperations())
+		client.CancelAll()
+	})
 	t.Run("localThreshold", func(t *testing.T) {
 		testCases := []struct {
 			name              string
@@ -261,6 +329,95 @@ func TestClient(t *testing.T) {
 			assert.Equal(t, uri, got, "expected GetURI to return %v, got %v", uri, got)
 		})
 	})
+	t.Run("topology stats", func(t *testing.T) {
+		desc := description.Topology{
+			Kind: description.ReplicaSetWithPrimary,
+			Servers: []description.Server{
+				{Addr: "a:27017", Kind: description.RSPrimary, AverageRTT: 10 * time.Millisecond, AverageRTTSet: true},
+				{Addr: "b:27017", Kind: description.RSSecondary, AverageRTT: 20 * time.Millisecond, AverageRTTSet: true},
+				{Addr: "c:27017", Kind: description.RSSecondary, AverageRTT: 30 * time.Millisecond, AverageRTTSet: true},
+				{Addr: "d:27017", Kind: description.Unknown},
+			},
+		}
+		expected := TopologyStats{
+			ServerCount:    4,
+			HasPrimary:     true,
+			SecondaryCount: 2,
+			AverageRTT:     20 * time.Millisecond,
+		}
+		stats := newTopologyStats(desc)
+		assert.Equal(t, expected, stats, "expected stats %+v, got %+v", expected, stats)
+
+		client := setupClient()
+		clientStats := client.Stats()
+		assert.Equal(t, 0, len(clientStats.Pools), "expected no pools before Connect, got %v", clientStats.Pools)
+		_, err := client.ConnectionStats(address.Address("localhost:27017"))
+		assert.NotNil(t, err, "expected ConnectionStats error for unknown server, got nil")
+	})
+	t.Run("topology changes since", func(t *testing.T) {
+		a := description.Server{Addr: "a:27017", Kind: description.RSPrimary}
+		b := description.Server{Addr: "b:27017", Kind: description.RSSecondary}
+		c := description.Server{Addr: "c:27017", Kind: description.RSSecondary}
+		desc := description.Topology{Kind: description.ReplicaSetWithPrimary, Servers: []description.Server{a, c}}
+		client := &Client{deployment: describedDeployment{desc: desc}}
+
+		previous := description.Topology{Kind: description.ReplicaSetWithPrimary, Servers: []description.Server{a, b}}
+		diff, current := client.TopologyChangesSince(previous)
+		assert.Equal(t, []description.Server{c}, diff.Added, "expected added %v, got %v", []description.Server{c}, diff.Added)
+		assert.Equal(t, []description.Server{b}, diff.Removed, "expected removed %v, got %v",
+			[]description.Server{b}, diff.Removed)
+		assert.Equal(t, desc, current, "expected description %v, got %v", desc, current)
+
+		current.Servers[0] = b
+		assert.Equal(t, a, desc.Servers[0], "expected deployment description to be unchanged")
+
+		diff, _ = client.TopologyChangesSince(desc)
+		assert.Equal(t, 0, len(diff.Added)+len(diff.Removed), "expected no changes, got %+v", diff)
+	})
+	t.Run("ready", func(t *testing.T) {
+		unknown := description.Topology{
+			Kind:    description.ReplicaSetNoPrimary,
+			Servers: []description.Server{{Addr: "a:27017", Kind: description.RSSecondary}},
+		}
+		withPrimary := description.Topology{
+			Kind:    description.ReplicaSetWithPrimary,
+			Servers: []description.Server{{Addr: "a:27017", Kind: description.RSPrimary}},
+		}
+
+		t.Run("writable server discovered", func(t *testing.T) {
+			client := &Client{deployment: subscribedDeployment{updates: []description.Topology{unknown, withPrimary}}}
+			err := client.Ready(bgCtx)
+			assert.Nil(t, err, "Ready error: %v", err)
+		})
+		t.Run("timeout", func(t *testing.T) {
+			client := &Client{deployment: subscribedDeployment{updates: []description.Topology{unknown}}}
+			ctx, cancel := context.WithTimeout(bgCtx, 50*time.Millisecond)
+			defer cancel()
+			err := client.Ready(ctx)
+			assert.NotNil(t, err, "expected Ready error, got nil")
+			assert.True(t, strings.Contains(err.Error(), unknown.String()),
+				"expected error to contain topology %q, got %q", unknown.String(), err.Error())
+		})
+		t.Run("deployment without subscriptions", func(t *testing.T) {
+			client := &Client{deployment: mockDeployment{}}
+			err := client.Ready(bgCtx)
+			assert.Nil(t, err, "Ready error: %v", err)
+		})
+		t.Run("connect disconnects on timeout", func(t *testing.T) {
+			var disconnected bool
+			wait := 50 * time.Millisecond
+			client := &Client{
+				deployment: disconnectedDeployment{
+					subscribedDeployment: subscribedDeployment{updates: []description.Topology{unknown}},
+					disconnected:         &disconnected,
+				},
+				waitForConn: &wait,
+			}
+			err := client.Connect(bgCtx)
+			assert.NotNil(t, err, "expected Connect error, got nil")
+			assert.True(t, disconnected, "expected the deployment to be disconnected")
+		})
+	})
 	t.Run("endSessions", func(t *testing.T) {
 		cs := testutil.ConnString(t)
 		originalBatchSize := endSessionsBatchSize
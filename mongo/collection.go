@@ -10,6 +10,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
+	"math/big"
 	"strings"
 	"time"
 
@@ -38,6 +40,7 @@ type Collection struct {
 	readSelector   description.ServerSelector
 	writeSelector  description.ServerSelector
 	registry       *bsoncodec.Registry
+	timeout        *time.Duration
 }
 
 // aggregateParams is used to store information to configure an Aggregate operation.
@@ -54,6 +57,8 @@ type aggregateParams struct {
 	readSelector   description.ServerSelector
 	writeSelector  description.ServerSelector
 	readPreference *readpref.ReadPref
+	causalClock    *causalClock
+	timeout        *time.Duration
 	opts           []*options.AggregateOptions
 }
 
@@ -86,6 +91,11 @@ func newCollection(db *Database, name string, opts ...*options.CollectionOptions
 		reg = collOpt.Registry
 	}
 
+	timeout := db.timeout
+	if collOpt.DefaultTimeout != nil {
+		timeout = collOpt.DefaultTimeout
+	}
+
 	readSelector := description.CompositeSelector([]description.ServerSelector{
 		description.ReadPrefSelector(rp),
 		description.LatencySelector(db.client.localThreshold),
@@ -106,6 +116,7 @@ func newCollection(db *Database, name string, opts ...*options.CollectionOptions
 		readSelector:   readSelector,
 		writeSelector:  writeSelector,
 		registry:       reg,
+		timeout:        timeout,
 	}
 
 	return coll
@@ -122,6 +133,7 @@ func (coll *Collection) copy() *Collection {
 		readSelector:   coll.readSelector,
 		writeSelector:  coll.writeSelector,
 		registry:       coll.registry,
+		timeout:        coll.timeout,
 	}
 }
 
@@ -148,6 +160,10 @@ func (coll *Collection) Clone(opts ...*options.CollectionOptions) (*Collection,
 		copyColl.registry = optsColl.Registry
 	}
 
+	if optsColl.DefaultTimeout != nil {
+		copyColl.timeout = optsColl.DefaultTimeout
+	}
+
 	copyColl.readSelector = description.CompositeSelector([]description.ServerSelector{
 		description.ReadPrefSelector(copyColl.readPreference),
 		description.LatencySelector(copyColl.client.localThreshold),
@@ -166,6 +182,63 @@ func (coll *Collection) Database() *Database {
 	return coll.db
 }
 
+// EffectiveReadPreference returns the read preference that would be used for a read operation on the collection. If
+// opOverride is not nil, it is returned because a read preference specified for an operation takes precedence.
+// Otherwise, the collection's read preference is returned, which is resolved from the CollectionOptions, the
+// DatabaseOptions, and the ClientOptions in that order, falling back to primary.
+//
+// The read preference of a transaction takes precedence over all of these for operations run in the transaction, and
+// aggregations with a $out or $merge stage may be sent to the primary regardless of the read preference.
+func (coll *Collection) EffectiveReadPreference(opOverride *readpref.ReadPref) *readpref.ReadPref {
+	if opOverride != nil {
+		return opOverride
+	}
+	if coll.readPreference != nil {
+		return coll.readPreference
+	}
+	return readpref.Primary()
+}
+
+// SelectedServerWireVersion selects a server for the given read preference and returns the minimum and maximum wire
+// versions supported by that server. No operation is run against the selected server. If rp is nil, the Collection's
+// read preference is used.
+//
+// Because a later operation performs its own server selection, it is not guaranteed to run on the same server. The
+// result is intended for deciding whether features that require a newer server can be used.
+func (coll *Collection) SelectedServerWireVersion(ctx context.Context, rp *readpref.ReadPref) (min, max int32, err error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if rp == nil {
+		rp = coll.readPreference
+	}
+
+	selector := description.CompositeSelector([]description.ServerSelector{
+		description.ReadPrefSelector(rp),
+		description.LatencySelector(coll.client.localThreshold),
+	})
+	srvr, err := coll.client.deployment.SelectServer(ctx, selector)
+	if err != nil {
+		return 0, 0, replaceErrors(err)
+	}
+
+	var wv *description.VersionRange
+	if ds, ok := srvr.(interface{ Description() description.SelectedServer }); ok {
+		wv = ds.Description().WireVersion
+	} else {
+		conn, err := srvr.Connection(ctx)
+		if err != nil {
+			return 0, 0, replaceErrors(err)
+		}
+		wv = conn.Description().WireVersion
+		_ = conn.Close()
+	}
+	if wv == nil {
+		return 0, 0, errors.New("selected server did not report a wire version")
+	}
+	return wv.Min, wv.Max, nil
+}
+
 // BulkWrite performs a bulk write operation (https://docs.mongodb.com/manual/core/bulk-write-operations/).
 //
 // The models parameter must be a slice of operations to be executed in this bulk write. It cannot be nil or empty.
@@ -187,11 +260,11 @@ func (coll *Collection) BulkWrite(ctx context.Context, models []WriteModel,
 	sess := sessionFromContext(ctx)
 	if sess == nil && coll.client.sessionPool != nil {
 		var err error
-		sess, err = session.NewClientSession(coll.client.sessionPool, coll.client.id, session.Implicit)
+		sess, err = coll.db.causalClock.newImplicitSession(coll.client)
 		if err != nil {
 			return nil, err
 		}
-		defer sess.EndSession()
+		defer coll.db.causalClock.endImplicitSession(sess)
 	}
 
 	err := coll.client.validSession(sess)
@@ -232,6 +305,96 @@ func (coll *Collection) BulkWrite(ctx context.Context, models []WriteModel,
 	return &op.result, replaceErrors(err)
 }
 
+// BulkUpsertByKey upserts documents in a single bulk write, using the value of keyField in each document to identify
+// the document to replace. One ReplaceOneModel with upsert enabled is generated per distinct key value. If multiple
+// documents share the same key value, the last one wins and replaces the earlier ones in the batch, so the bulk write
+// never contains conflicting upserts for the same key.
+//
+// The keyField parameter is the name of the key field and may use dot notation to refer to an embedded field. Every
+// document must contain it. Numeric key values are compared by value as the server does, so int32(1), int64(1) and 1.0
+// are the same key. Other values, including embedded documents and arrays that contain numbers, are compared by their
+// BSON type and bytes.
+//
+// The documents parameter must be a slice of documents to upsert. The slice cannot be nil or empty. The elements must
+// all be non-nil.
+//
+// The opts parameter can be used to specify options for the operation (see the options.BulkWriteOptions documentation.)
+func (coll *Collection) BulkUpsertByKey(ctx context.Context, keyField string, documents []interface{},
+	opts ...*options.BulkWriteOptions) (*BulkWriteResult, error) {
+
+	models, err := bulkUpsertModels(coll.registry, keyField, documents)
+	if err != nil {
+		return nil, err
+	}
+	return coll.BulkWrite(ctx, models, opts...)
+}
+
+// bulkUpsertModels builds the deduplicated ReplaceOneModels used by BulkUpsertByKey.
+func bulkUpsertModels(registry *bsoncodec.Registry, keyField string, documents []interface{}) ([]WriteModel, error) {
+	if keyField == "" {
+		return nil, errors.New("keyField must not be empty")
+	}
+	if len(documents) == 0 {
+		return nil, ErrEmptySlice
+	}
+
+	models := make([]WriteModel, 0, len(documents))
+	indexes := make(map[string]int, len(documents))
+	for i, document := range documents {
+		if document == nil {
+			return nil, ErrNilDocument
+		}
+		doc, err := transformBsoncoreDocument(registry, document)
+		if err != nil {
+			return nil, err
+		}
+		key, err := doc.LookupErr(strings.Split(keyField, ".")...)
+		if err != nil {
+			return nil, fmt.Errorf("document at index %d does not contain key field %q", i, keyField)
+		}
+
+		filter := bsoncore.BuildDocumentFromElements(nil, bsoncore.AppendValueElement(nil, keyField, key))
+		model := NewReplaceOneModel().SetFilter(bson.Raw(filter)).SetReplacement(bson.Raw(doc)).SetUpsert(true)
+
+		id := upsertKeyID(key)
+		if idx, ok := indexes[id]; ok {
+			models[idx] = model
+			continue
+		}
+		indexes[id] = len(models)
+		models = append(models, model)
+	}
+	return models, nil
+}
+
+// upsertKeyID returns the string used by bulkUpsertModels to compare key values. Finite numbers of any numeric type
+// that are equal as values have the same ID. Other values are identified by their BSON type and bytes.
+func upsertKeyID(key bsoncore.Value) string {
+	var r *big.Rat
+	switch key.Type {
+	case bsontype.Int32:
+		r = big.NewRat(int64(key.Int32()), 1)
+	case bsontype.Int64:
+		r = big.NewRat(key.Int64(), 1)
+	case bsontype.Double:
+		// SetFloat64 returns nil for NaN and infinities.
+		r = new(big.Rat).SetFloat64(key.Double())
+	case bsontype.Decimal128:
+		if bi, exp, err := key.Decimal128().BigInt(); err == nil {
+			scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(math.Abs(float64(exp)))), nil)
+			if exp >= 0 {
+				r = new(big.Rat).SetInt(bi.Mul(bi, scale))
+			} else {
+				r = new(big.Rat).SetFrac(bi, scale)
+			}
+		}
+	}
+	if r != nil {
+		return "number:" + r.RatString()
+	}
+	return string(key.Type) + string(key.Data)
+}
+
 func (coll *Collection) insert(ctx context.Context, documents []interface{},
 	opts ...*options.InsertManyOptions) ([]interface{}, error) {
 
@@ -253,11 +416,11 @@ func (coll *Collection) insert(ctx context.Context, documents []interface{},
 	sess := sessionFromContext(ctx)
 	if sess == nil && coll.client.sessionPool != nil {
 		var err error
-		sess, err = session.NewClientSession(coll.client.sessionPool, coll.client.id, session.Implicit)
+		sess, err = coll.db.causalClock.newImplicitSession(coll.client)
 		if err != nil {
 			return nil, err
 		}
-		defer sess.EndSession()
+		defer coll.db.causalClock.endImplicitSession(sess)
 	}
 
 	err := coll.client.validSession(sess)
@@ -277,13 +440,17 @@ func (coll *Collection) insert(ctx context.Context, documents []interface{},
 
 	op := operation.NewInsert(docs...).
 		Session(sess).WriteConcern(wc).CommandMonitor(coll.client.monitor).
-		ServerSelector(selector).ClusterClock(coll.client.clock).
+		ServerSelector(selector).Timeout(coll.timeout).ClusterClock(coll.client.clock).
 		Database(coll.db.name).Collection(coll.name).
-		Deployment(coll.client.deployment).Crypt(coll.client.crypt).Ordered(true)
+		Deployment(coll.client.deployment).Crypt(coll.client.crypt).Ordered(true).
+		SkipDocumentSizeValidation(!coll.client.validateDocSize)
 	imo := options.MergeInsertManyOptions(opts...)
 	if imo.BypassDocumentValidation != nil && *imo.BypassDocumentValidation {
 		op = op.BypassDocumentValidation(*imo.BypassDocumentValidation)
 	}
+	if imo.BypassEmptyTimestampReplacement != nil {
+		op = op.BypassEmptyTsReplacement(*imo.BypassEmptyTimestampReplacement)
+	}
 	if imo.Ordered != nil {
 		op = op.Ordered(*imo.Ordered)
 	}
@@ -291,7 +458,7 @@ func (coll *Collection) insert(ctx context.Context, documents []interface{},
 	if coll.client.retryWrites {
 		retry = driver.RetryOncePerCommand
 	}
-	op = op.Retry(retry)
+	op = op.Retry(retry).RetryTimeout(coll.client.retryTimeout)
 
 	err = op.Execute(ctx)
 	wce, ok := err.(driver.WriteCommandError)
@@ -332,6 +499,9 @@ func (coll *Collection) InsertOne(ctx context.Context, document interface{},
 		if opt.BypassDocumentValidation != nil && *opt.BypassDocumentValidation {
 			imo = imo.SetBypassDocumentValidation(*opt.BypassDocumentValidation)
 		}
+		if opt.BypassEmptyTimestampReplacement != nil {
+			imo = imo.SetBypassEmptyTimestampReplacement(*opt.BypassEmptyTimestampReplacement)
+		}
 		imOpts[i] = imo
 	}
 	res, err := coll.insert(ctx, []interface{}{document}, imOpts...)
@@ -351,6 +521,12 @@ func (coll *Collection) InsertOne(ctx context.Context, document interface{},
 // automatically to the marshalled document. The original document will not be modified. The _id values for the inserted
 // documents can be retrieved from the InsertedIDs field of the returnd InsertManyResult.
 //
+// By default, the documents are inserted in order and the operation stops at the first write error, so documents after
+// the failing one are not inserted. If the Ordered option is set to false (e.g. using
+// options.InsertMany().SetUnordered()), the server attempts to insert every document and the returned
+// BulkWriteException contains a write error for each document that failed, identified by its index in the documents
+// slice.
+//
 // The opts parameter can be used to specify options for the operation (see the options.InsertManyOptions documentation.)
 //
 // For more information about the command, see https://docs.mongodb.com/manual/reference/command/insert/.
@@ -407,11 +583,11 @@ func (coll *Collection) delete(ctx context.Context, filter interface{}, deleteOn
 
 	sess := sessionFromContext(ctx)
 	if sess == nil && coll.client.sessionPool != nil {
-		sess, err = session.NewClientSession(coll.client.sessionPool, coll.client.id, session.Implicit)
+		sess, err = coll.db.causalClock.newImplicitSession(coll.client)
 		if err != nil {
 			return nil, err
 		}
-		defer sess.EndSession()
+		defer coll.db.causalClock.endImplicitSession(sess)
 	}
 
 	err = coll.client.validSession(sess)
@@ -441,7 +617,7 @@ func (coll *Collection) delete(ctx context.Context, filter interface{}, deleteOn
 		doc = bsoncore.AppendDocumentElement(doc, "collation", do.Collation.ToDocument())
 	}
 	if do.Hint != nil {
-		hint, err := transformValue(coll.registry, do.Hint)
+		hint, err := transformHint(coll.registry, do.Hint)
 		if err != nil {
 			return nil, err
 		}
@@ -452,7 +628,7 @@ func (coll *Collection) delete(ctx context.Context, filter interface{}, deleteOn
 
 	op := operation.NewDelete(doc).
 		Session(sess).WriteConcern(wc).CommandMonitor(coll.client.monitor).
-		ServerSelector(selector).ClusterClock(coll.client.clock).
+		ServerSelector(selector).Timeout(coll.timeout).ClusterClock(coll.client.clock).
 		Database(coll.db.name).Collection(coll.name).
 		Deployment(coll.client.deployment).Crypt(coll.client.crypt).Ordered(true)
 	if do.Hint != nil {
@@ -464,7 +640,7 @@ func (coll *Collection) delete(ctx context.Context, filter interface{}, deleteOn
 	if deleteOne && coll.client.retryWrites {
 		retryMode = driver.RetryOncePerCommand
 	}
-	op = op.Retry(retryMode)
+	op = op.Retry(retryMode).RetryTimeout(coll.client.retryTimeout)
 	rr, err := processWriteError(op.Execute(ctx))
 	if rr&expectedRr == 0 {
 		return nil, err
@@ -504,6 +680,81 @@ func (coll *Collection) DeleteMany(ctx context.Context, filter interface{},
 	return coll.delete(ctx, filter, false, rrMany, opts...)
 }
 
+// DeleteManyBatched deletes all documents matching filter in batches of at most batchSize documents, waiting for
+// pauseBetween between batches. Each batch finds the _id values of up to batchSize matching documents and then deletes
+// those documents. Spreading a large delete out over time this way gives secondaries a chance to keep up and avoids
+// the replication lag caused by a single large DeleteMany.
+//
+// The filter parameter must be a document containing query operators and cannot be nil. The batchSize parameter must
+// be positive. The number of documents deleted is returned even if an error occurs partway through. If ctx expires
+// while waiting between batches, ctx.Err() is returned.
+//
+// The _id values are always read from the primary, and each delete also requires the documents to still match filter.
+// The batches are not run atomically, so documents that match filter and are inserted while the delete is running may
+// also be deleted.
+func (coll *Collection) DeleteManyBatched(ctx context.Context, filter interface{}, batchSize int,
+	pauseBetween time.Duration) (int64, error) {
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if batchSize <= 0 {
+		return 0, errors.New("batchSize must be positive")
+	}
+
+	f, err := transformBsoncoreDocument(coll.registry, filter)
+	if err != nil {
+		return 0, err
+	}
+	// A secondary could return _id values of documents that no longer match filter on the primary.
+	primaryColl, err := coll.Clone(options.Collection().SetReadPreference(readpref.Primary()))
+	if err != nil {
+		return 0, err
+	}
+
+	findOpts := options.Find().SetProjection(bson.D{{"_id", 1}}).SetLimit(int64(batchSize))
+	var deleted int64
+	for {
+		cursor, err := primaryColl.Find(ctx, bson.Raw(f), findOpts)
+		if err != nil {
+			return deleted, err
+		}
+		ids := make(bson.A, 0, batchSize)
+		for cursor.Next(ctx) {
+			ids = append(ids, cursor.Current.Lookup("_id"))
+		}
+		err = cursor.Err()
+		_ = cursor.Close(ctx)
+		if err != nil {
+			return deleted, err
+		}
+		if len(ids) == 0 {
+			return deleted, nil
+		}
+
+		res, err := coll.DeleteMany(ctx, bson.D{{"$and", bson.A{bson.Raw(f), bson.D{{"_id", bson.D{{"$in", ids}}}}}}})
+		if res != nil {
+			deleted += res.DeletedCount
+		}
+		if err != nil {
+			return deleted, err
+		}
+		if len(ids) < batchSize {
+			return deleted, nil
+		}
+
+		if pauseBetween > 0 {
+			timer := time.NewTimer(pauseBetween)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return deleted, ctx.Err()
+			case <-timer.C:
+			}
+		}
+	}
+}
+
 func (coll *Collection) updateOrReplace(ctx context.Context, filter bsoncore.Document, update interface{}, multi bool,
 	expectedRr returnResult, checkDollarKey bool, opts ...*options.UpdateOptions) (*UpdateResult, error) {
 
@@ -524,11 +775,11 @@ func (coll *Collection) updateOrReplace(ctx context.Context, filter bsoncore.Doc
 	sess := sessionFromContext(ctx)
 	if sess == nil && coll.client.sessionPool != nil {
 		var err error
-		sess, err = session.NewClientSession(coll.client.sessionPool, coll.client.id, session.Implicit)
+		sess, err = coll.db.causalClock.newImplicitSession(coll.client)
 		if err != nil {
 			return nil, err
 		}
-		defer sess.EndSession()
+		defer coll.db.causalClock.endImplicitSession(sess)
 	}
 
 	err = coll.client.validSession(sess)
@@ -548,7 +799,7 @@ func (coll *Collection) updateOrReplace(ctx context.Context, filter bsoncore.Doc
 
 	op := operation.NewUpdate(updateDoc).
 		Session(sess).WriteConcern(wc).CommandMonitor(coll.client.monitor).
-		ServerSelector(selector).ClusterClock(coll.client.clock).
+		ServerSelector(selector).Timeout(coll.timeout).ClusterClock(coll.client.clock).
 		Database(coll.db.name).Collection(coll.name).
 		Deployment(coll.client.deployment).Crypt(coll.client.crypt).Hint(uo.Hint != nil).
 		ArrayFilters(uo.ArrayFilters != nil).Ordered(true)
@@ -561,7 +812,7 @@ func (coll *Collection) updateOrReplace(ctx context.Context, filter bsoncore.Doc
 	if !multi && coll.client.retryWrites {
 		retry = driver.RetryOncePerCommand
 	}
-	op = op.Retry(retry)
+	op = op.Retry(retry).RetryTimeout(coll.client.retryTimeout)
 	err = op.Execute(ctx)
 
 	rr, err := processWriteError(err)
@@ -709,15 +960,82 @@ func (coll *Collection) Aggregate(ctx context.Context, pipeline interface{},
 		writeConcern:   coll.writeConcern,
 		retryRead:      coll.client.retryReads,
 		db:             coll.db.name,
+		causalClock:    coll.db.causalClock,
 		col:            coll.name,
 		readSelector:   coll.readSelector,
 		writeSelector:  coll.writeSelector,
 		readPreference: coll.readPreference,
+		timeout:        coll.timeout,
 		opts:           opts,
 	}
 	return aggregate(a)
 }
 
+// AggregateSchema executes an aggregate command against the collection and infers the shape of its results from the
+// first sampleSize documents returned. The result maps the dotted path of each field found in the sampled documents to
+// the alias of its BSON type as used by the $type query operator (e.g. "int", "string", or "object"). Fields of
+// embedded documents are reported using dotted paths in addition to the embedded document itself. Arrays are reported
+// as "array" and their elements are not inspected. If a field has different types in different documents, the type
+// aliases are sorted and joined by "|" (e.g. "int|string").
+//
+// The pipeline parameter has the same requirements as the pipeline parameter for Aggregate. The sampleSize parameter
+// must be positive.
+func (coll *Collection) AggregateSchema(ctx context.Context, pipeline interface{}, sampleSize int) (map[string]string, error) {
+	if sampleSize <= 0 {
+		return nil, errors.New("sampleSize must be positive")
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	batchSize := int32(math.MaxInt32)
+	if sampleSize < math.MaxInt32 {
+		batchSize = int32(sampleSize)
+	}
+	cursor, err := coll.Aggregate(ctx, pipeline, options.Aggregate().SetBatchSize(batchSize))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	schema := make(schemaSample)
+	for i := 0; i < sampleSize && cursor.Next(ctx); i++ {
+		if err = schema.add("", cursor.Current); err != nil {
+			return nil, err
+		}
+	}
+	if err = cursor.Err(); err != nil {
+		return nil, err
+	}
+	return schema.types(), nil
+}
+
+// IndexUsageStats executes an aggregate command with a $indexStats stage against the collection and returns the usage
+// statistics for each of the collection's indexes. Indexes with an AccessOps value of 0 have not been used since the
+// statistics were last reset and may be candidates for removal. For a sharded collection, the statistics for each
+// index are reported separately by every shard, so an index can appear more than once with different Host values.
+//
+// This operation requires MongoDB version >= 3.2.
+//
+// For more information about the $indexStats stage, see
+// https://docs.mongodb.com/manual/reference/operator/aggregation/indexStats/.
+func (coll *Collection) IndexUsageStats(ctx context.Context) ([]IndexUsage, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	cursor, err := coll.Aggregate(ctx, bson.A{bson.D{{"$indexStats", bson.D{}}}})
+	if err != nil {
+		return nil, err
+	}
+
+	var stats []IndexUsage
+	if err = cursor.All(ctx, &stats); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
 // aggreate is the helper method for Aggregate
 func aggregate(a aggregateParams) (*Cursor, error) {
 
@@ -732,7 +1050,7 @@ func aggregate(a aggregateParams) (*Cursor, error) {
 
 	sess := sessionFromContext(a.ctx)
 	if sess == nil && a.client.sessionPool != nil {
-		sess, err = session.NewClientSession(a.client.sessionPool, a.client.id, session.Implicit)
+		sess, err = a.causalClock.newImplicitSession(a.client)
 		if err != nil {
 			return nil, err
 		}
@@ -745,10 +1063,14 @@ func aggregate(a aggregateParams) (*Cursor, error) {
 	if hasOutputStage {
 		wc = a.writeConcern
 	}
-	rc := a.readConcern
+	ao := options.MergeAggregateOptions(a.opts...)
+	rc, err := operationReadConcern(sess, a.readConcern, ao.ReadConcern)
+	if err != nil {
+		closeImplicitSession(sess)
+		return nil, err
+	}
 	if sess.TransactionRunning() {
 		wc = nil
-		rc = nil
 	}
 	if !writeconcern.AckWrite(wc) {
 		closeImplicitSession(sess)
@@ -760,10 +1082,10 @@ func aggregate(a aggregateParams) (*Cursor, error) {
 		selector = makeReadPrefSelector(sess, a.readSelector, a.client.localThreshold)
 	}
 
-	ao := options.MergeAggregateOptions(a.opts...)
 	cursorOpts := driver.CursorOptions{
 		CommandMonitor: a.client.monitor,
 		Crypt:          a.client.crypt,
+		Timeout:        a.timeout,
 	}
 
 	op := operation.NewAggregate(pipelineArr).
@@ -771,7 +1093,7 @@ func aggregate(a aggregateParams) (*Cursor, error) {
 		WriteConcern(wc).
 		ReadConcern(rc).
 		CommandMonitor(a.client.monitor).
-		ServerSelector(selector).
+		ServerSelector(selector).Timeout(a.timeout).
 		ClusterClock(a.client.clock).
 		Database(a.db).
 		Collection(a.col).
@@ -799,7 +1121,8 @@ func aggregate(a aggregateParams) (*Cursor, error) {
 		op.Collation(bsoncore.Document(ao.Collation.ToDocument()))
 	}
 	if ao.MaxTime != nil {
-		op.MaxTimeMS(int64(*ao.MaxTime / time.Millisecond))
+		// An explicit maxTime bounds the operation in place of the default timeout.
+		op.MaxTimeMS(int64(*ao.MaxTime / time.Millisecond)).Timeout(ao.MaxTime)
 	}
 	if ao.MaxAwaitTime != nil {
 		cursorOpts.MaxTimeMS = int64(*ao.MaxAwaitTime / time.Millisecond)
@@ -808,13 +1131,28 @@ func aggregate(a aggregateParams) (*Cursor, error) {
 		op.Comment(*ao.Comment)
 	}
 	if ao.Hint != nil {
-		hintVal, err := transformValue(a.registry, ao.Hint)
+		hintVal, err := transformHint(a.registry, ao.Hint)
 		if err != nil {
 			closeImplicitSession(sess)
 			return nil, err
 		}
 		op.Hint(hintVal)
 	}
+	var letDoc bsoncore.Document
+	if ao.Let != nil {
+		letDoc, err = transformBsoncoreDocument(a.registry, ao.Let)
+		if err != nil {
+			closeImplicitSession(sess)
+			return nil, err
+		}
+		op.Let(letDoc)
+	}
+	if ao.ValidateLetVariables != nil && *ao.ValidateLetVariables {
+		if err = validateLetVariables(pipelineArr, letDoc); err != nil {
+			closeImplicitSession(sess)
+			return nil, err
+		}
+	}
 
 	retry := driver.RetryNone
 	if a.retryRead && !hasOutputStage {
@@ -830,13 +1168,14 @@ func aggregate(a aggregateParams) (*Cursor, error) {
 		}
 		return nil, replaceErrors(err)
 	}
+	a.causalClock.advance(sess)
 
 	bc, err := op.Result(cursorOpts)
 	if err != nil {
 		closeImplicitSession(sess)
 		return nil, replaceErrors(err)
 	}
-	cursor, err := newCursorWithSession(bc, a.registry, sess)
+	cursor, err := newCursorWithSession(wrapReadAhead(bc, ao.ReadAhead, sess), a.registry, sess)
 	return cursor, replaceErrors(err)
 }
 
@@ -845,7 +1184,9 @@ func aggregate(a aggregateParams) (*Cursor, error) {
 //
 // The filter parameter must be a document and can be used to select which documents contribute to the count. It
 // cannot be nil. An empty document (e.g. bson.D{}) should be used to count all documents in the collection. This will
-// result in a full collection scan.
+// result in a full collection scan. The count is always computed by an aggregation, even for an empty filter, so any
+// hint specified via options.CountOptions.SetHint is applied. Use EstimatedDocumentCount for a count based on the
+// collection metadata.
 //
 // The opts parameter can be used to specify options for the operation (see the options.CountOptions documentation).
 func (coll *Collection) CountDocuments(ctx context.Context, filter interface{},
@@ -864,33 +1205,34 @@ func (coll *Collection) CountDocuments(ctx context.Context, filter interface{},
 
 	sess := sessionFromContext(ctx)
 	if sess == nil && coll.client.sessionPool != nil {
-		sess, err = session.NewClientSession(coll.client.sessionPool, coll.client.id, session.Implicit)
+		sess, err = coll.db.causalClock.newImplicitSession(coll.client)
 		if err != nil {
 			return 0, err
 		}
-		defer sess.EndSession()
+		defer coll.db.causalClock.endImplicitSession(sess)
 	}
 	if err = coll.client.validSession(sess); err != nil {
 		return 0, err
 	}
 
-	rc := coll.readConcern
-	if sess.TransactionRunning() {
-		rc = nil
+	rc, err := operationReadConcern(sess, coll.readConcern, countOpts.ReadConcern)
+	if err != nil {
+		return 0, err
 	}
 
 	selector := makeReadPrefSelector(sess, coll.readSelector, coll.client.localThreshold)
 	op := operation.NewAggregate(pipelineArr).Session(sess).ReadConcern(rc).ReadPreference(coll.readPreference).
 		CommandMonitor(coll.client.monitor).ServerSelector(selector).ClusterClock(coll.client.clock).Database(coll.db.name).
+		Timeout(coll.timeout).
 		Collection(coll.name).Deployment(coll.client.deployment).Crypt(coll.client.crypt)
 	if countOpts.Collation != nil {
 		op.Collation(bsoncore.Document(countOpts.Collation.ToDocument()))
 	}
 	if countOpts.MaxTime != nil {
-		op.MaxTimeMS(int64(*countOpts.MaxTime / time.Millisecond))
+		op.MaxTimeMS(int64(*countOpts.MaxTime / time.Millisecond)).Timeout(countOpts.MaxTime)
 	}
 	if countOpts.Hint != nil {
-		hintVal, err := transformValue(coll.registry, countOpts.Hint)
+		hintVal, err := transformHint(coll.registry, countOpts.Hint)
 		if err != nil {
 			return 0, err
 		}
@@ -943,11 +1285,11 @@ func (coll *Collection) EstimatedDocumentCount(ctx context.Context,
 
 	var err error
 	if sess == nil && coll.client.sessionPool != nil {
-		sess, err = session.NewClientSession(coll.client.sessionPool, coll.client.id, session.Implicit)
+		sess, err = coll.db.causalClock.newImplicitSession(coll.client)
 		if err != nil {
 			return 0, err
 		}
-		defer sess.EndSession()
+		defer coll.db.causalClock.endImplicitSession(sess)
 	}
 
 	err = coll.client.validSession(sess)
@@ -964,11 +1306,11 @@ func (coll *Collection) EstimatedDocumentCount(ctx context.Context,
 	op := operation.NewCount().Session(sess).ClusterClock(coll.client.clock).
 		Database(coll.db.name).Collection(coll.name).CommandMonitor(coll.client.monitor).
 		Deployment(coll.client.deployment).ReadConcern(rc).ReadPreference(coll.readPreference).
-		ServerSelector(selector).Crypt(coll.client.crypt)
+		ServerSelector(selector).Timeout(coll.timeout).Crypt(coll.client.crypt)
 
 	co := options.MergeEstimatedDocumentCountOptions(opts...)
 	if co.MaxTime != nil {
-		op = op.MaxTimeMS(int64(*co.MaxTime / time.Millisecond))
+		op = op.MaxTimeMS(int64(*co.MaxTime / time.Millisecond)).Timeout(co.MaxTime)
 	}
 	retry := driver.RetryNone
 	if coll.client.retryReads {
@@ -1006,11 +1348,11 @@ func (coll *Collection) Distinct(ctx context.Context, fieldName string, filter i
 	sess := sessionFromContext(ctx)
 
 	if sess == nil && coll.client.sessionPool != nil {
-		sess, err = session.NewClientSession(coll.client.sessionPool, coll.client.id, session.Implicit)
+		sess, err = coll.db.causalClock.newImplicitSession(coll.client)
 		if err != nil {
 			return nil, err
 		}
-		defer sess.EndSession()
+		defer coll.db.causalClock.endImplicitSession(sess)
 	}
 
 	err = coll.client.validSession(sess)
@@ -1030,13 +1372,20 @@ func (coll *Collection) Distinct(ctx context.Context, fieldName string, filter i
 		Session(sess).ClusterClock(coll.client.clock).
 		Database(coll.db.name).Collection(coll.name).CommandMonitor(coll.client.monitor).
 		Deployment(coll.client.deployment).ReadConcern(rc).ReadPreference(coll.readPreference).
-		ServerSelector(selector).Crypt(coll.client.crypt)
+		ServerSelector(selector).Timeout(coll.timeout).Crypt(coll.client.crypt)
 
 	if option.Collation != nil {
 		op.Collation(bsoncore.Document(option.Collation.ToDocument()))
 	}
 	if option.MaxTime != nil {
-		op.MaxTimeMS(int64(*option.MaxTime / time.Millisecond))
+		op.MaxTimeMS(int64(*option.MaxTime / time.Millisecond)).Timeout(option.MaxTime)
+	}
+	if option.Hint != nil {
+		hint, err := transformHint(coll.registry, option.Hint)
+		if err != nil {
+			return nil, err
+		}
+		op.Hint(hint)
 	}
 	retry := driver.RetryNone
 	if coll.client.retryReads {
@@ -1095,7 +1444,7 @@ func (coll *Collection) Find(ctx context.Context, filter interface{},
 	sess := sessionFromContext(ctx)
 	if sess == nil && coll.client.sessionPool != nil {
 		var err error
-		sess, err = session.NewClientSession(coll.client.sessionPool, coll.client.id, session.Implicit)
+		sess, err = coll.db.causalClock.newImplicitSession(coll.client)
 		if err != nil {
 			return nil, err
 		}
@@ -1107,22 +1456,24 @@ func (coll *Collection) Find(ctx context.Context, filter interface{},
 		return nil, err
 	}
 
-	rc := coll.readConcern
-	if sess.TransactionRunning() {
-		rc = nil
+	fo := options.MergeFindOptions(opts...)
+	rc, err := operationReadConcern(sess, coll.readConcern, fo.ReadConcern)
+	if err != nil {
+		closeImplicitSession(sess)
+		return nil, err
 	}
 
 	selector := makeReadPrefSelector(sess, coll.readSelector, coll.client.localThreshold)
 	op := operation.NewFind(f).
 		Session(sess).ReadConcern(rc).ReadPreference(coll.readPreference).
-		CommandMonitor(coll.client.monitor).ServerSelector(selector).
+		CommandMonitor(coll.client.monitor).ServerSelector(selector).Timeout(coll.timeout).
 		ClusterClock(coll.client.clock).Database(coll.db.name).Collection(coll.name).
 		Deployment(coll.client.deployment).Crypt(coll.client.crypt)
 
-	fo := options.MergeFindOptions(opts...)
 	cursorOpts := driver.CursorOptions{
 		CommandMonitor: coll.client.monitor,
 		Crypt:          coll.client.crypt,
+		Timeout:        coll.timeout,
 	}
 
 	if fo.AllowDiskUse != nil {
@@ -1151,7 +1502,7 @@ func (coll *Collection) Find(ctx context.Context, filter interface{},
 		}
 	}
 	if fo.Hint != nil {
-		hint, err := transformValue(coll.registry, fo.Hint)
+		hint, err := transformHint(coll.registry, fo.Hint)
 		if err != nil {
 			closeImplicitSession(sess)
 			return nil, err
@@ -1179,7 +1530,7 @@ func (coll *Collection) Find(ctx context.Context, filter interface{},
 		cursorOpts.MaxTimeMS = int64(*fo.MaxAwaitTime / time.Millisecond)
 	}
 	if fo.MaxTime != nil {
-		op.MaxTimeMS(int64(*fo.MaxTime / time.Millisecond))
+		op.MaxTimeMS(int64(*fo.MaxTime / time.Millisecond)).Timeout(fo.MaxTime)
 	}
 	if fo.Min != nil {
 		min, err := transformBsoncoreDocument(coll.registry, fo.Min)
@@ -1215,6 +1566,17 @@ func (coll *Collection) Find(ctx context.Context, filter interface{},
 	if fo.Snapshot != nil {
 		op.Snapshot(*fo.Snapshot)
 	}
+	if fo.NaturalSort != nil {
+		if fo.Sort != nil {
+			closeImplicitSession(sess)
+			return nil, errors.New("the NaturalSort and Sort options cannot both be set")
+		}
+		if *fo.NaturalSort != 1 && *fo.NaturalSort != -1 {
+			closeImplicitSession(sess)
+			return nil, fmt.Errorf("natural sort direction must be 1 or -1, got %d", *fo.NaturalSort)
+		}
+		op.Sort(bsoncore.NewDocumentBuilder().AppendInt32("$natural", int32(*fo.NaturalSort)).Build())
+	}
 	if fo.Sort != nil {
 		sort, err := transformBsoncoreDocument(coll.registry, fo.Sort)
 		if err != nil {
@@ -1233,13 +1595,20 @@ func (coll *Collection) Find(ctx context.Context, filter interface{},
 		closeImplicitSession(sess)
 		return nil, replaceErrors(err)
 	}
+	coll.db.causalClock.advance(sess)
 
 	bc, err := op.Result(cursorOpts)
 	if err != nil {
 		closeImplicitSession(sess)
 		return nil, replaceErrors(err)
 	}
-	return newCursorWithSession(bc, coll.registry, sess)
+	cursor, err := newCursorWithSession(wrapReadAhead(bc, fo.ReadAhead, sess), coll.registry, sess)
+	if err != nil {
+		return nil, err
+	}
+	cursor.filter = fo.ClientSideFilter
+	cursor.tailableAwait = fo.CursorType != nil && *fo.CursorType == options.TailableAwait
+	return cursor, nil
 }
 
 // FindOne executes a find command and returns a SingleResult for one document in the collection.
@@ -1288,7 +1657,8 @@ func (coll *Collection) FindOne(ctx context.Context, filter interface{},
 	return &SingleResult{cur: cursor, reg: coll.registry, err: replaceErrors(err)}
 }
 
-func (coll *Collection) findAndModify(ctx context.Context, op *operation.FindAndModify) *SingleResult {
+// findAndModify runs op. An explicit maxTime bounds the operation in place of the collection's default timeout.
+func (coll *Collection) findAndModify(ctx context.Context, op *operation.FindAndModify, maxTime *time.Duration) *SingleResult {
 	if ctx == nil {
 		ctx = context.Background()
 	}
@@ -1296,11 +1666,11 @@ func (coll *Collection) findAndModify(ctx context.Context, op *operation.FindAnd
 	sess := sessionFromContext(ctx)
 	var err error
 	if sess == nil && coll.client.sessionPool != nil {
-		sess, err = session.NewClientSession(coll.client.sessionPool, coll.client.id, session.Implicit)
+		sess, err = coll.db.causalClock.newImplicitSession(coll.client)
 		if err != nil {
 			return &SingleResult{err: err}
 		}
-		defer sess.EndSession()
+		defer coll.db.causalClock.endImplicitSession(sess)
 	}
 
 	err = coll.client.validSession(sess)
@@ -1323,15 +1693,19 @@ func (coll *Collection) findAndModify(ctx context.Context, op *operation.FindAnd
 		retry = driver.RetryOnce
 	}
 
+	timeout := coll.timeout
+	if maxTime != nil {
+		timeout = maxTime
+	}
 	op = op.Session(sess).
 		WriteConcern(wc).
 		CommandMonitor(coll.client.monitor).
-		ServerSelector(selector).
+		ServerSelector(selector).Timeout(timeout).
 		ClusterClock(coll.client.clock).
 		Database(coll.db.name).
 		Collection(coll.name).
 		Deployment(coll.client.deployment).
-		Retry(retry).
+		Retry(retry).RetryTimeout(coll.client.retryTimeout).
 		Crypt(coll.client.crypt)
 
 	_, err = processWriteError(op.Execute(ctx))
@@ -1383,14 +1757,14 @@ func (coll *Collection) FindOneAndDelete(ctx context.Context, filter interface{}
 		op = op.Sort(sort)
 	}
 	if fod.Hint != nil {
-		hint, err := transformValue(coll.registry, fod.Hint)
+		hint, err := transformHint(coll.registry, fod.Hint)
 		if err != nil {
 			return &SingleResult{err: err}
 		}
 		op = op.Hint(hint)
 	}
 
-	return coll.findAndModify(ctx, op)
+	return coll.findAndModify(ctx, op, fod.MaxTime)
 }
 
 // FindOneAndReplace executes a findAndModify command to replace at most one document in the collection
@@ -1454,14 +1828,14 @@ func (coll *Collection) FindOneAndReplace(ctx context.Context, filter interface{
 		op = op.Upsert(*fo.Upsert)
 	}
 	if fo.Hint != nil {
-		hint, err := transformValue(coll.registry, fo.Hint)
+		hint, err := transformHint(coll.registry, fo.Hint)
 		if err != nil {
 			return &SingleResult{err: err}
 		}
 		op = op.Hint(hint)
 	}
 
-	return coll.findAndModify(ctx, op)
+	return coll.findAndModify(ctx, op, fo.MaxTime)
 }
 
 // FindOneAndUpdate executes a findAndModify command to update at most one document in the collection and returns the
@@ -1537,14 +1911,14 @@ func (coll *Collection) FindOneAndUpdate(ctx context.Context, filter interface{}
 		op = op.Upsert(*fo.Upsert)
 	}
 	if fo.Hint != nil {
-		hint, err := transformValue(coll.registry, fo.Hint)
+		hint, err := transformHint(coll.registry, fo.Hint)
 		if err != nil {
 			return &SingleResult{err: err}
 		}
 		op = op.Hint(hint)
 	}
 
-	return coll.findAndModify(ctx, op)
+	return coll.findAndModify(ctx, op, fo.MaxTime)
 }
 
 // Watch returns a change stream for all changes on the corresponding collection. See
@@ -1572,6 +1946,7 @@ func (coll *Collection) Watch(ctx context.Context, pipeline interface{},
 		collectionName: coll.Name(),
 		databaseName:   coll.db.Name(),
 		crypt:          coll.client.crypt,
+		timeout:        coll.timeout,
 	}
 	return newChangeStream(ctx, csConfig, pipeline, opts...)
 }
@@ -1591,11 +1966,11 @@ func (coll *Collection) Drop(ctx context.Context) error {
 	sess := sessionFromContext(ctx)
 	if sess == nil && coll.client.sessionPool != nil {
 		var err error
-		sess, err = session.NewClientSession(coll.client.sessionPool, coll.client.id, session.Implicit)
+		sess, err = coll.db.causalClock.newImplicitSession(coll.client)
 		if err != nil {
 			return err
 		}
-		defer sess.EndSession()
+		defer coll.db.causalClock.endImplicitSession(sess)
 	}
 
 	err := coll.client.validSession(sess)
@@ -1615,7 +1990,7 @@ func (coll *Collection) Drop(ctx context.Context) error {
 
 	op := operation.NewDropCollection().
 		Session(sess).WriteConcern(wc).CommandMonitor(coll.client.monitor).
-		ServerSelector(selector).ClusterClock(coll.client.clock).
+		ServerSelector(selector).Timeout(coll.timeout).ClusterClock(coll.client.clock).
 		Database(coll.db.name).Collection(coll.name).
 		Deployment(coll.client.deployment).Crypt(coll.client.crypt)
 	err = op.Execute(ctx)
@@ -1650,3 +2025,19 @@ func makeReadPrefSelector(sess *session.Client, selector description.ServerSelec
 
 	return makePinnedSelector(sess, selector)
 }
+
+// operationReadConcern returns the read concern to send for an operation. The override, if set, takes precedence over
+// defaultRC. No read concern is sent for operations in a transaction because the read concern is set when the
+// transaction is started, so an error is returned if an override is specified in that case.
+func operationReadConcern(sess *session.Client, defaultRC, override *readconcern.ReadConcern) (*readconcern.ReadConcern, error) {
+	if sess.TransactionRunning() {
+		if override != nil {
+			return nil, ErrReadConcernInTransaction
+		}
+		return nil, nil
+	}
+	if override != nil {
+		return override, nil
+	}
+	return defaultRC, nil
+}
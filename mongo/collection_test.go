@@ -9,13 +9,19 @@ package mongo
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/internal/testutil/assert"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.mongodb.org/mongo-driver/mongo/readconcern"
 	"go.mongodb.org/mongo-driver/mongo/readpref"
 	"go.mongodb.org/mongo-driver/mongo/writeconcern"
+	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
+	"go.mongodb.org/mongo-driver/x/mongo/driver/drivertest"
+	"go.mongodb.org/mongo-driver/x/mongo/driver/session"
+	"go.mongodb.org/mongo-driver/x/mongo/driver/uuid"
 )
 
 const (
@@ -75,6 +81,87 @@ func TestCollection(t *testing.T) {
 		}
 		compareColls(t, expected, coll)
 	})
+	t.Run("default timeout", func(t *testing.T) {
+		client := setupClient(options.Client().SetTimeout(5 * time.Second))
+		assert.Equal(t, 5*time.Second, *client.Database("foo").Collection("bar").timeout,
+			"expected collection to inherit client timeout")
+
+		db := client.Database("foo", options.Database().SetDefaultTimeout(10*time.Second))
+		got := *db.Collection("bar").timeout
+		assert.Equal(t, 10*time.Second, got, "expected timeout %v, got %v", 10*time.Second, got)
+
+		coll := db.Collection("bar", options.Collection().SetDefaultTimeout(time.Minute))
+		got = *coll.timeout
+		assert.Equal(t, time.Minute, got, "expected timeout %v, got %v", time.Minute, got)
+
+		clone, err := coll.Clone(options.Collection().SetDefaultTimeout(time.Hour))
+		assert.Nil(t, err, "Clone error: %v", err)
+		got = *clone.timeout
+		assert.Equal(t, time.Hour, got, "expected timeout %v, got %v", time.Hour, got)
+	})
+	t.Run("max time overrides default timeout", func(t *testing.T) {
+		var deadline time.Time
+		var hasDeadline bool
+		conn := deadlineConnection{
+			ChannelConn: &drivertest.ChannelConn{},
+			deadline:    &deadline,
+			hasDeadline: &hasDeadline,
+		}
+		client := setupClient(&options.ClientOptions{Deployment: deadlineDeployment{server: deadlineServer{conn: conn}}})
+		coll := client.Database("foo").Collection("bar", options.Collection().SetDefaultTimeout(time.Second))
+
+		testCases := []struct {
+			name string
+			run  func() error
+		}{
+			{"find", func() error {
+				_, err := coll.Find(bgCtx, bson.D{}, options.Find().SetMaxTime(time.Hour))
+				return err
+			}},
+			{"distinct", func() error {
+				_, err := coll.Distinct(bgCtx, "x", bson.D{}, options.Distinct().SetMaxTime(time.Hour))
+				return err
+			}},
+			{"countDocuments", func() error {
+				_, err := coll.CountDocuments(bgCtx, bson.D{}, options.Count().SetMaxTime(time.Hour))
+				return err
+			}},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				start := time.Now()
+				err := tc.run()
+				assert.NotNil(t, err, "expected error, got nil")
+				assert.True(t, hasDeadline, "expected the operation to have a deadline")
+				want := start.Add(time.Hour)
+				assert.True(t, !deadline.Before(want) && deadline.Before(want.Add(time.Minute)),
+					"expected deadline around %v, got %v", want, deadline)
+			})
+		}
+	})
+	t.Run("effective read preference", func(t *testing.T) {
+		client := setupClient(options.Client().SetReadPreference(readpref.Nearest()))
+		coll := client.Database("foo").Collection("bar")
+		got := coll.EffectiveReadPreference(nil)
+		assert.Equal(t, readpref.NearestMode, got.Mode(), "expected mode %v, got %v", readpref.NearestMode, got.Mode())
+
+		db := client.Database("foo", options.Database().SetReadPreference(readpref.Secondary()))
+		got = db.Collection("bar").EffectiveReadPreference(nil)
+		assert.Equal(t, readpref.SecondaryMode, got.Mode(), "expected mode %v, got %v", readpref.SecondaryMode,
+			got.Mode())
+
+		coll = db.Collection("bar", options.Collection().SetReadPreference(readpref.PrimaryPreferred()))
+		got = coll.EffectiveReadPreference(nil)
+		assert.Equal(t, readpref.PrimaryPreferredMode, got.Mode(), "expected mode %v, got %v",
+			readpref.PrimaryPreferredMode, got.Mode())
+
+		got = coll.EffectiveReadPreference(readpref.SecondaryPreferred())
+		assert.Equal(t, readpref.SecondaryPreferredMode, got.Mode(), "expected mode %v, got %v",
+			readpref.SecondaryPreferredMode, got.Mode())
+
+		got = setupColl("bar").EffectiveReadPreference(nil)
+		assert.Equal(t, readpref.PrimaryMode, got.Mode(), "expected mode %v, got %v", readpref.PrimaryMode, got.Mode())
+	})
 	t.Run("replace topology error", func(t *testing.T) {
 		coll := setupColl("foo")
 		doc := bson.D{}
@@ -213,4 +300,80 @@ func TestCollection(t *testing.T) {
 		_, err = coll.Watch(bgCtx, nil)
 		assert.Equal(t, aggErr, err, "expected error %v, got %v", aggErr, err)
 	})
+	t.Run("natural sort validation", func(t *testing.T) {
+		coll := setupColl("foo")
+
+		_, err := coll.Find(bgCtx, bson.D{}, options.Find().SetNaturalSort(2))
+		assert.NotNil(t, err, "expected error for invalid natural sort direction, got nil")
+
+		_, err = coll.Find(bgCtx, bson.D{}, options.Find().SetNaturalSort(1).SetSort(bson.D{{"x", 1}}))
+		assert.NotNil(t, err, "expected error for natural sort combined with sort, got nil")
+	})
+	t.Run("bulk upsert by key", func(t *testing.T) {
+		coll := setupColl("foo")
+
+		_, err := coll.BulkUpsertByKey(bgCtx, "sku", nil)
+		assert.Equal(t, ErrEmptySlice, err, "expected error %v, got %v", ErrEmptySlice, err)
+		_, err = coll.BulkUpsertByKey(bgCtx, "sku", []interface{}{bson.D{{"name", "a"}}})
+		assert.NotNil(t, err, "expected error for missing key field, got nil")
+
+		docs := []interface{}{
+			bson.D{{"sku", "a"}, {"qty", 1}},
+			bson.D{{"sku", "b"}, {"qty", 2}},
+			bson.D{{"sku", "a"}, {"qty", 3}},
+		}
+		models, err := bulkUpsertModels(bson.DefaultRegistry, "sku", docs)
+		assert.Nil(t, err, "bulkUpsertModels error: %v", err)
+		assert.Equal(t, 2, len(models), "expected 2 models, got %v", len(models))
+
+		first := models[0].(*ReplaceOneModel)
+		wantFilter := bson.Raw(bsoncore.BuildDocumentFromElements(nil, bsoncore.AppendStringElement(nil, "sku", "a")))
+		assert.Equal(t, wantFilter, first.Filter, "expected filter %v, got %v", wantFilter, first.Filter)
+		qty := first.Replacement.(bson.Raw).Lookup("qty").Int32()
+		assert.Equal(t, int32(3), qty, "expected last document to win, got qty %v", qty)
+		assert.True(t, first.Upsert != nil && *first.Upsert, "expected upsert to be true")
+
+		dec, err := primitive.ParseDecimal128("1.0")
+		assert.Nil(t, err, "ParseDecimal128 error: %v", err)
+		docs = []interface{}{
+			bson.D{{"sku", int32(1)}},
+			bson.D{{"sku", int64(1)}},
+			bson.D{{"sku", 1.0}},
+			bson.D{{"sku", dec}},
+			bson.D{{"sku", 1.5}},
+			bson.D{{"sku", "1"}},
+		}
+		models, err = bulkUpsertModels(bson.DefaultRegistry, "sku", docs)
+		assert.Nil(t, err, "bulkUpsertModels error: %v", err)
+		assert.Equal(t, 3, len(models), "expected equal numbers to share a model, got %v models", len(models))
+	})
+	t.Run("delete many batched", func(t *testing.T) {
+		coll := setupColl("foo")
+
+		_, err := coll.DeleteManyBatched(bgCtx, bson.D{}, 0, 0)
+		assert.NotNil(t, err, "expected error for non-positive batch size, got nil")
+	})
+	t.Run("operation read concern", func(t *testing.T) {
+		collRC := readconcern.Majority()
+		opRC := readconcern.Local()
+
+		rc, err := operationReadConcern(nil, collRC, nil)
+		assert.Nil(t, err, "operationReadConcern error: %v", err)
+		assert.Equal(t, collRC, rc, "expected read concern %v, got %v", collRC, rc)
+		rc, err = operationReadConcern(nil, collRC, opRC)
+		assert.Nil(t, err, "operationReadConcern error: %v", err)
+		assert.Equal(t, opRC, rc, "expected read concern %v, got %v", opRC, rc)
+
+		sess, err := session.NewClientSession(session.NewPool(nil), uuid.UUID{}, session.Explicit)
+		assert.Nil(t, err, "NewClientSession error: %v", err)
+		defer sess.EndSession()
+		err = sess.StartTransaction(nil)
+		assert.Nil(t, err, "StartTransaction error: %v", err)
+
+		rc, err = operationReadConcern(sess, collRC, nil)
+		assert.Nil(t, err, "operationReadConcern error: %v", err)
+		assert.Nil(t, rc, "expected no read concern in a transaction, got %v", rc)
+		_, err = operationReadConcern(sess, collRC, opRC)
+		assert.Equal(t, ErrReadConcernInTransaction, err, "expected error %v, got %v", ErrReadConcernInTransaction, err)
+	})
 }
@@ -12,9 +12,11 @@ import (
 	"fmt"
 	"io"
 	"reflect"
+	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/bsoncodec"
+	"go.mongodb.org/mongo-driver/tag"
 	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
 	"go.mongodb.org/mongo-driver/x/mongo/driver"
 	"go.mongodb.org/mongo-driver/x/mongo/driver/session"
@@ -32,6 +34,9 @@ type Cursor struct {
 	batchLength   int
 	registry      *bsoncodec.Registry
 	clientSession *session.Client
+	filter        func(bson.Raw) bool
+	tailableAwait bool
+	largestDoc    int
 
 	err error
 }
@@ -96,7 +101,19 @@ func (c *Cursor) TryNext(ctx context.Context) bool {
 	return c.next(ctx, true)
 }
 
+// next gets the next document for which the cursor's filter, if any, returns true.
 func (c *Cursor) next(ctx context.Context, nonBlocking bool) bool {
+	for {
+		if !c.nextDocument(ctx, nonBlocking) {
+			return false
+		}
+		if c.filter == nil || c.filter(c.Current) {
+			return true
+		}
+	}
+}
+
+func (c *Cursor) nextDocument(ctx context.Context, nonBlocking bool) bool {
 	// return false right away if the cursor has already errored.
 	if c.err != nil {
 		return false
@@ -111,6 +128,7 @@ func (c *Cursor) next(ctx context.Context, nonBlocking bool) bool {
 		// Consume the next document in the current batch.
 		c.batchLength--
 		c.Current = bson.Raw(doc)
+		c.recordDocumentSize(doc)
 		return true
 	case io.EOF: // Need to do a getMore
 	default:
@@ -154,6 +172,7 @@ func (c *Cursor) next(ctx context.Context, nonBlocking bool) bool {
 		case nil:
 			c.batchLength--
 			c.Current = bson.Raw(doc)
+			c.recordDocumentSize(doc)
 			return true
 		case io.EOF: // Empty batch so we continue
 		default:
@@ -185,9 +204,22 @@ func (c *Cursor) Close(ctx context.Context) error {
 //
 // This method requires driver version >= 1.1.0.
 func (c *Cursor) All(ctx context.Context, results interface{}) error {
+	_, err := c.all(ctx, results, false)
+	return err
+}
+
+// AllOrPartial is like All, but if the context is cancelled or its deadline is exceeded while the cursor is being
+// iterated, results is set to the documents decoded so far and the context's error is returned with complete set to
+// false. If all documents are retrieved, complete is true and the error is nil. Any other error is returned with
+// complete set to false and results is not modified.
+func (c *Cursor) AllOrPartial(ctx context.Context, results interface{}) (complete bool, err error) {
+	return c.all(ctx, results, true)
+}
+
+func (c *Cursor) all(ctx context.Context, results interface{}, allowPartial bool) (bool, error) {
 	resultsVal := reflect.ValueOf(results)
 	if resultsVal.Kind() != reflect.Ptr {
-		return fmt.Errorf("results argument must be a pointer to a slice, but was a %s", resultsVal.Kind())
+		return false, fmt.Errorf("results argument must be a pointer to a slice, but was a %s", resultsVal.Kind())
 	}
 
 	sliceVal := resultsVal.Elem()
@@ -196,7 +228,7 @@ func (c *Cursor) All(ctx context.Context, results interface{}) error {
 	}
 
 	if sliceVal.Kind() != reflect.Slice {
-		return fmt.Errorf("results argument must be a pointer to a slice, but was a pointer to %s", sliceVal.Kind())
+		return false, fmt.Errorf("results argument must be a pointer to a slice, but was a pointer to %s", sliceVal.Kind())
 	}
 
 	elementType := sliceVal.Type().Elem()
@@ -209,7 +241,7 @@ func (c *Cursor) All(ctx context.Context, results interface{}) error {
 	for {
 		sliceVal, index, err = c.addFromBatch(sliceVal, elementType, batch, index)
 		if err != nil {
-			return err
+			return false, err
 		}
 
 		if !c.bc.Next(ctx) {
@@ -220,19 +252,61 @@ func (c *Cursor) All(ctx context.Context, results interface{}) error {
 	}
 
 	if err = c.bc.Err(); err != nil {
-		return err
+		if ctxErr := ctx.Err(); allowPartial && ctxErr != nil {
+			resultsVal.Elem().Set(sliceVal.Slice(0, index))
+			return false, ctxErr
+		}
+		return false, err
 	}
 
 	resultsVal.Elem().Set(sliceVal.Slice(0, index))
-	return nil
+	return true, nil
 }
 
 // RemainingBatchLength returns the number of documents left in the current batch. If this returns zero, the subsequent
-// call to Next or TryNext will do a network request to fetch the next batch.
+// call to Next or TryNext will do a network request to fetch the next batch. If the cursor was created with a
+// client-side filter, the count includes documents that the filter may skip.
 func (c *Cursor) RemainingBatchLength() int {
 	return c.batchLength
 }
 
+// LargestDocumentSize returns the size in bytes of the largest document returned by the server that has been seen by
+// Next, TryNext, All, or AllOrPartial. Documents skipped by a client-side filter are included. If no documents have
+// been seen, LargestDocumentSize returns 0. This can be compared against the maxBsonObjectSize of the server to detect
+// documents that are nearing the limit.
+func (c *Cursor) LargestDocumentSize() int {
+	return c.largestDoc
+}
+
+// SelectedServerTags returns the tags of the server that the cursor was created on. All getMore commands for the cursor
+// are sent to the same server. If the server has no tags or the cursor was not created by an operation, nil is
+// returned.
+func (c *Cursor) SelectedServerTags() tag.Set {
+	if sd, ok := c.bc.(serverDescriber); ok {
+		return sd.ServerDescription().Tags
+	}
+	return nil
+}
+
+// SetMaxAwaitTime sets the maximum amount of time that the server waits for new documents to satisfy each subsequent
+// getMore command on the cursor. It can only be used on a cursor created by Find with the CursorType option set to
+// options.TailableAwait; an error is returned for any other cursor, including cursors created with the ReadAhead
+// option. SetMaxAwaitTime must not be called concurrently with Next or TryNext.
+func (c *Cursor) SetMaxAwaitTime(dur time.Duration) error {
+	if !c.tailableAwait {
+		return errors.New("SetMaxAwaitTime can only be used with a tailable await cursor")
+	}
+	if dur < 0 {
+		return fmt.Errorf("max await time must not be negative, got %v", dur)
+	}
+	setter, ok := c.bc.(interface{ SetMaxTime(time.Duration) })
+	if !ok {
+		return errors.New("the max await time of this cursor cannot be changed")
+	}
+	setter.SetMaxTime(dur)
+	return nil
+}
+
 // addFromBatch adds all documents from batch to sliceVal starting at the given index. It returns the new slice value,
 // the next empty index in the slice, and an error if one occurs.
 func (c *Cursor) addFromBatch(sliceVal reflect.Value, elemType reflect.Type, batch *bsoncore.DocumentSequence,
@@ -244,6 +318,10 @@ func (c *Cursor) addFromBatch(sliceVal reflect.Value, elemType reflect.Type, bat
 	}
 
 	for _, doc := range docs {
+		c.recordDocumentSize(doc)
+		if c.filter != nil && !c.filter(bson.Raw(doc)) {
+			continue
+		}
 		if sliceVal.Len() == index {
 			// slice is full
 			newElem := reflect.New(elemType)
@@ -262,6 +340,13 @@ func (c *Cursor) addFromBatch(sliceVal reflect.Value, elemType reflect.Type, bat
 	return sliceVal, index, nil
 }
 
+// recordDocumentSize updates the largest document size seen by the cursor.
+func (c *Cursor) recordDocumentSize(doc bsoncore.Document) {
+	if len(doc) > c.largestDoc {
+		c.largestDoc = len(doc)
+	}
+}
+
 func (c *Cursor) closeImplicitSession() {
 	if c.clientSession != nil && c.clientSession.SessionType == session.Implicit {
 		c.clientSession.EndSession()
@@ -9,9 +9,12 @@ package mongo
 import (
 	"context"
 	"testing"
+	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/internal/testutil/assert"
+	"go.mongodb.org/mongo-driver/mongo/description"
+	"go.mongodb.org/mongo-driver/tag"
 	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
 	"go.mongodb.org/mongo-driver/x/mongo/driver"
 )
@@ -85,6 +88,50 @@ func (tbc *testBatchCursor) Close(context.Context) error {
 	return nil
 }
 
+// cancelingBatchCursor is a testBatchCursor that cancels the context and reports the cancellation as an error once
+// the given number of batches have been returned.
+type cancelingBatchCursor struct {
+	*testBatchCursor
+	cancel  context.CancelFunc
+	batches int
+	err     error
+}
+
+func (cbc *cancelingBatchCursor) Next(ctx context.Context) bool {
+	if cbc.batches == 0 {
+		cbc.cancel()
+		cbc.err = ctx.Err()
+		return false
+	}
+
+	cbc.batches--
+	return cbc.testBatchCursor.Next(ctx)
+}
+
+func (cbc *cancelingBatchCursor) Err() error {
+	return cbc.err
+}
+
+// maxTimeBatchCursor is a testBatchCursor that records the max time set through SetMaxTime.
+type maxTimeBatchCursor struct {
+	*testBatchCursor
+	maxTime time.Duration
+}
+
+func (mbc *maxTimeBatchCursor) SetMaxTime(dur time.Duration) {
+	mbc.maxTime = dur
+}
+
+// describedBatchCursor is a testBatchCursor that reports a fixed server description.
+type describedBatchCursor struct {
+	*testBatchCursor
+	desc description.Server
+}
+
+func (dbc *describedBatchCursor) ServerDescription() description.Server {
+	return dbc.desc
+}
+
 func TestCursor(t *testing.T) {
 	t.Run("loops until docs available", func(t *testing.T) {})
 	t.Run("returns false on context cancellation", func(t *testing.T) {})
@@ -178,4 +225,169 @@ func TestCursor(t *testing.T) {
 			assert.NotNil(t, err, "expected error, got: %v", err)
 		})
 	})
+	t.Run("TestAllOrPartial", func(t *testing.T) {
+		t.Run("returns all documents", func(t *testing.T) {
+			cursor, err := newCursor(newTestBatchCursor(2, 5), nil)
+			assert.Nil(t, err, "newCursor error: %v", err)
+
+			var docs []bson.D
+			complete, err := cursor.AllOrPartial(context.Background(), &docs)
+			assert.Nil(t, err, "AllOrPartial error: %v", err)
+			assert.True(t, complete, "expected complete to be true")
+			assert.Equal(t, 10, len(docs), "expected 10 docs, got %v", len(docs))
+		})
+		t.Run("returns partial results on context cancellation", func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			tbc := &cancelingBatchCursor{
+				testBatchCursor: newTestBatchCursor(3, 5),
+				cancel:          cancel,
+				batches:         1,
+			}
+			cursor, err := newCursor(tbc, nil)
+			assert.Nil(t, err, "newCursor error: %v", err)
+
+			var docs []bson.D
+			complete, err := cursor.AllOrPartial(ctx, &docs)
+			assert.Equal(t, context.Canceled, err, "expected error %v, got %v", context.Canceled, err)
+			assert.False(t, complete, "expected complete to be false")
+			assert.Equal(t, 5, len(docs), "expected 5 docs, got %v", len(docs))
+			for index, doc := range docs {
+				expected := bson.D{{"foo", int32(index)}}
+				assert.Equal(t, expected, doc, "expected doc %v, got %v", expected, doc)
+			}
+		})
+		t.Run("All discards documents on context cancellation", func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			tbc := &cancelingBatchCursor{
+				testBatchCursor: newTestBatchCursor(3, 5),
+				cancel:          cancel,
+				batches:         1,
+			}
+			cursor, err := newCursor(tbc, nil)
+			assert.Nil(t, err, "newCursor error: %v", err)
+
+			var docs []bson.D
+			err = cursor.All(ctx, &docs)
+			assert.NotNil(t, err, "expected error, got nil")
+			assert.Equal(t, 0, len(docs), "expected 0 docs, got %v", len(docs))
+		})
+	})
+	t.Run("client-side filter", func(t *testing.T) {
+		even := func(doc bson.Raw) bool {
+			return doc.Lookup("foo").Int32()%2 == 0
+		}
+
+		t.Run("Next skips documents", func(t *testing.T) {
+			cursor, err := newCursor(newTestBatchCursor(3, 3), nil)
+			assert.Nil(t, err, "newCursor error: %v", err)
+			cursor.filter = even
+
+			var got []int32
+			for cursor.Next(context.Background()) {
+				got = append(got, cursor.Current.Lookup("foo").Int32())
+			}
+			assert.Nil(t, cursor.Err(), "cursor error: %v", cursor.Err())
+			expected := []int32{0, 2, 4, 6, 8}
+			assert.Equal(t, expected, got, "expected values %v, got %v", expected, got)
+		})
+		t.Run("All skips documents", func(t *testing.T) {
+			cursor, err := newCursor(newTestBatchCursor(2, 5), nil)
+			assert.Nil(t, err, "newCursor error: %v", err)
+			cursor.filter = even
+
+			var docs []bson.D
+			err = cursor.All(context.Background(), &docs)
+			assert.Nil(t, err, "All error: %v", err)
+			assert.Equal(t, 5, len(docs), "expected 5 docs, got %v", len(docs))
+			for index, doc := range docs {
+				expected := bson.D{{"foo", int32(index * 2)}}
+				assert.Equal(t, expected, doc, "expected doc %v, got %v", expected, doc)
+			}
+		})
+	})
+	t.Run("SetMaxAwaitTime", func(t *testing.T) {
+		t.Run("tailable await cursor", func(t *testing.T) {
+			bc := &maxTimeBatchCursor{testBatchCursor: newTestBatchCursor(1, 1)}
+			cursor, err := newCursor(bc, nil)
+			assert.Nil(t, err, "newCursor error: %v", err)
+			cursor.tailableAwait = true
+
+			err = cursor.SetMaxAwaitTime(2 * time.Second)
+			assert.Nil(t, err, "SetMaxAwaitTime error: %v", err)
+			assert.Equal(t, 2*time.Second, bc.maxTime, "expected max time %v, got %v", 2*time.Second, bc.maxTime)
+
+			err = cursor.SetMaxAwaitTime(-time.Second)
+			assert.NotNil(t, err, "expected error for negative duration, got nil")
+		})
+		t.Run("other cursor types", func(t *testing.T) {
+			cursor, err := newCursor(&maxTimeBatchCursor{testBatchCursor: newTestBatchCursor(1, 1)}, nil)
+			assert.Nil(t, err, "newCursor error: %v", err)
+			err = cursor.SetMaxAwaitTime(time.Second)
+			assert.NotNil(t, err, "expected error for non-tailable cursor, got nil")
+
+			cursor, err = newCursor(newReadAheadBatchCursor(newTestBatchCursor(1, 1)), nil)
+			assert.Nil(t, err, "newCursor error: %v", err)
+			cursor.tailableAwait = true
+			err = cursor.SetMaxAwaitTime(time.Second)
+			assert.NotNil(t, err, "expected error for read-ahead cursor, got nil")
+		})
+	})
+	t.Run("SelectedServerTags", func(t *testing.T) {
+		tags := tag.Set{{Name: "dc", Value: "east"}}
+		bc := &describedBatchCursor{testBatchCursor: newTestBatchCursor(1, 1), desc: description.Server{Tags: tags}}
+
+		cursor, err := newCursor(bc, nil)
+		assert.Nil(t, err, "newCursor error: %v", err)
+		got := cursor.SelectedServerTags()
+		assert.Equal(t, tags, got, "expected tags %v, got %v", tags, got)
+
+		cursor, err = newCursor(newReadAheadBatchCursor(bc), nil)
+		assert.Nil(t, err, "newCursor error: %v", err)
+		got = cursor.SelectedServerTags()
+		assert.Equal(t, tags, got, "expected tags %v, got %v", tags, got)
+
+		sr := &SingleResult{cur: cursor}
+		got = sr.SelectedServerTags()
+		assert.Equal(t, tags, got, "expected tags %v, got %v", tags, got)
+
+		cursor, err = newCursor(newTestBatchCursor(1, 1), nil)
+		assert.Nil(t, err, "newCursor error: %v", err)
+		assert.Nil(t, cursor.SelectedServerTags(), "expected nil tags, got %v", cursor.SelectedServerTags())
+	})
+	t.Run("LargestDocumentSize", func(t *testing.T) {
+		small := bsoncore.BuildDocumentFromElements(nil, bsoncore.AppendInt32Element(nil, "foo", 1))
+		large := bsoncore.BuildDocumentFromElements(nil, bsoncore.AppendStringElement(nil, "foo", "a large value"))
+		newSizedBatchCursor := func() *testBatchCursor {
+			return &testBatchCursor{batches: []*bsoncore.DocumentSequence{
+				{Style: bsoncore.SequenceStyle, Data: append(append([]byte{}, small...), small...)},
+				{Style: bsoncore.SequenceStyle, Data: append(append([]byte{}, large...), small...)},
+			}}
+		}
+
+		t.Run("Next", func(t *testing.T) {
+			cursor, err := newCursor(newSizedBatchCursor(), nil)
+			assert.Nil(t, err, "newCursor error: %v", err)
+			assert.Equal(t, 0, cursor.LargestDocumentSize(), "expected size 0, got %v", cursor.LargestDocumentSize())
+
+			assert.True(t, cursor.Next(context.Background()), "expected Next to return true")
+			assert.Equal(t, len(small), cursor.LargestDocumentSize(), "expected size %v, got %v", len(small),
+				cursor.LargestDocumentSize())
+			for cursor.Next(context.Background()) {
+			}
+			assert.Equal(t, len(large), cursor.LargestDocumentSize(), "expected size %v, got %v", len(large),
+				cursor.LargestDocumentSize())
+		})
+		t.Run("All", func(t *testing.T) {
+			cursor, err := newCursor(newSizedBatchCursor(), nil)
+			assert.Nil(t, err, "newCursor error: %v", err)
+
+			var docs []bson.Raw
+			err = cursor.All(context.Background(), &docs)
+			assert.Nil(t, err, "All error: %v", err)
+			assert.Equal(t, len(large), cursor.LargestDocumentSize(), "expected size %v, got %v", len(large),
+				cursor.LargestDocumentSize())
+		})
+	})
 }
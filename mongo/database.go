@@ -10,6 +10,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/bsoncodec"
@@ -39,6 +40,8 @@ type Database struct {
 	readSelector   description.ServerSelector
 	writeSelector  description.ServerSelector
 	registry       *bsoncodec.Registry
+	causalClock    *causalClock
+	timeout        *time.Duration
 }
 
 func newDatabase(client *Client, name string, opts ...*options.DatabaseOptions) *Database {
@@ -64,6 +67,11 @@ func newDatabase(client *Client, name string, opts ...*options.DatabaseOptions)
 		reg = dbOpt.Registry
 	}
 
+	timeout := client.timeout
+	if dbOpt.DefaultTimeout != nil {
+		timeout = dbOpt.DefaultTimeout
+	}
+
 	db := &Database{
 		client:         client,
 		name:           name,
@@ -71,6 +79,10 @@ func newDatabase(client *Client, name string, opts ...*options.DatabaseOptions)
 		readConcern:    rc,
 		writeConcern:   wc,
 		registry:       reg,
+		timeout:        timeout,
+	}
+	if dbOpt.CausalConsistencyDefault != nil && *dbOpt.CausalConsistencyDefault {
+		db.causalClock = client.causalClock(name)
 	}
 
 	db.readSelector = description.CompositeSelector([]description.ServerSelector{
@@ -124,9 +136,11 @@ func (db *Database) Aggregate(ctx context.Context, pipeline interface{},
 		writeConcern:   db.writeConcern,
 		retryRead:      db.client.retryReads,
 		db:             db.name,
+		causalClock:    db.causalClock,
 		readSelector:   db.readSelector,
 		writeSelector:  db.writeSelector,
 		readPreference: db.readPreference,
+		timeout:        db.timeout,
 		opts:           opts,
 	}
 	return aggregate(a)
@@ -137,7 +151,7 @@ func (db *Database) processRunCommand(ctx context.Context, cmd interface{},
 	sess := sessionFromContext(ctx)
 	if sess == nil && db.client.sessionPool != nil {
 		var err error
-		sess, err = session.NewClientSession(db.client.sessionPool, db.client.id, session.Implicit)
+		sess, err = db.causalClock.newImplicitSession(db.client)
 		if err != nil {
 			return nil, sess, err
 		}
@@ -165,11 +179,16 @@ func (db *Database) processRunCommand(ctx context.Context, cmd interface{},
 		readSelect = sess.PinnedServer
 	}
 
-	return operation.NewCommand(runCmdDoc).
+	op := operation.NewCommand(runCmdDoc).
 		Session(sess).CommandMonitor(db.client.monitor).
-		ServerSelector(readSelect).ClusterClock(db.client.clock).
+		ServerSelector(readSelect).Timeout(db.timeout).ClusterClock(db.client.clock).
 		Database(db.name).Deployment(db.client.deployment).ReadConcern(db.readConcern).
-		Crypt(db.client.crypt).ReadPreference(ro.ReadPreference), sess, nil
+		Crypt(db.client.crypt).ReadPreference(ro.ReadPreference)
+	if ro.Retryable != nil && *ro.Retryable && db.client.retryReads {
+		op = op.Retry(driver.RetryOncePerCommand)
+	}
+
+	return op, sess, nil
 }
 
 // RunCommand executes the given command against the database. This function does not obey the Database's read
@@ -192,10 +211,12 @@ func (db *Database) RunCommand(ctx context.Context, runCommand interface{}, opts
 	}
 
 	err = op.Execute(ctx)
+	db.causalClock.advance(sess)
 	return &SingleResult{
-		err: replaceErrors(err),
-		rdr: bson.Raw(op.Result()),
-		reg: db.registry,
+		err:  replaceErrors(err),
+		rdr:  bson.Raw(op.Result()),
+		reg:  db.registry,
+		tags: op.ServerDescription().Tags,
 	}
 }
 
@@ -224,8 +245,9 @@ func (db *Database) RunCommandCursor(ctx context.Context, runCommand interface{}
 		closeImplicitSession(sess)
 		return nil, replaceErrors(err)
 	}
+	db.causalClock.advance(sess)
 
-	bc, err := op.ResultCursor(driver.CursorOptions{})
+	bc, err := op.ResultCursor(driver.CursorOptions{Timeout: db.timeout})
 	if err != nil {
 		closeImplicitSession(sess)
 		return nil, replaceErrors(err)
@@ -244,11 +266,11 @@ func (db *Database) Drop(ctx context.Context) error {
 	sess := sessionFromContext(ctx)
 	if sess == nil && db.client.sessionPool != nil {
 		var err error
-		sess, err = session.NewClientSession(db.client.sessionPool, db.client.id, session.Implicit)
+		sess, err = db.causalClock.newImplicitSession(db.client)
 		if err != nil {
 			return err
 		}
-		defer sess.EndSession()
+		defer db.causalClock.endImplicitSession(sess)
 	}
 
 	err := db.client.validSession(sess)
@@ -268,7 +290,7 @@ func (db *Database) Drop(ctx context.Context) error {
 
 	op := operation.NewDropDatabase().
 		Session(sess).WriteConcern(wc).CommandMonitor(db.client.monitor).
-		ServerSelector(selector).ClusterClock(db.client.clock).
+		ServerSelector(selector).Timeout(db.timeout).ClusterClock(db.client.clock).
 		Database(db.name).Deployment(db.client.deployment).Crypt(db.client.crypt)
 
 	err = op.Execute(ctx)
@@ -337,7 +359,7 @@ func (db *Database) ListCollections(ctx context.Context, filter interface{}, opt
 
 	sess := sessionFromContext(ctx)
 	if sess == nil && db.client.sessionPool != nil {
-		sess, err = session.NewClientSession(db.client.sessionPool, db.client.id, session.Implicit)
+		sess, err = db.causalClock.newImplicitSession(db.client)
 		if err != nil {
 			return nil, err
 		}
@@ -358,7 +380,7 @@ func (db *Database) ListCollections(ctx context.Context, filter interface{}, opt
 	lco := options.MergeListCollectionsOptions(opts...)
 	op := operation.NewListCollections(filterDoc).
 		Session(sess).ReadPreference(db.readPreference).CommandMonitor(db.client.monitor).
-		ServerSelector(selector).ClusterClock(db.client.clock).
+		ServerSelector(selector).Timeout(db.timeout).ClusterClock(db.client.clock).
 		Database(db.name).Deployment(db.client.deployment).Crypt(db.client.crypt)
 	if lco.NameOnly != nil {
 		op = op.NameOnly(*lco.NameOnly)
@@ -378,8 +400,9 @@ func (db *Database) ListCollections(ctx context.Context, filter interface{}, opt
 		closeImplicitSession(sess)
 		return nil, replaceErrors(err)
 	}
+	db.causalClock.advance(sess)
 
-	bc, err := op.Result(driver.CursorOptions{Crypt: db.client.crypt})
+	bc, err := op.Result(driver.CursorOptions{Crypt: db.client.crypt, Timeout: db.timeout})
 	if err != nil {
 		closeImplicitSession(sess)
 		return nil, replaceErrors(err)
@@ -434,6 +457,42 @@ func (db *Database) ListCollectionNames(ctx context.Context, filter interface{},
 	return names, nil
 }
 
+// CurrentOp runs a $currentOp aggregation against the admin database and returns information about the operations in
+// progress on the selected server. The results are not limited to operations on this database; the Filter option can
+// be used to select operations by namespace or any other field. This method requires MongoDB version >= 3.6.
+//
+// The opts parameter can be used to specify options for the operation (see the options.CurrentOpOptions
+// documentation).
+//
+// For more information about the aggregation stage, see
+// https://docs.mongodb.com/manual/reference/operator/aggregation/currentOp/.
+func (db *Database) CurrentOp(ctx context.Context, opts ...*options.CurrentOpOptions) ([]*OpInfo, error) {
+	coo := options.MergeCurrentOpOptions(opts...)
+
+	stage := bson.D{}
+	if coo.AllUsers != nil {
+		stage = append(stage, bson.E{Key: "allUsers", Value: *coo.AllUsers})
+	}
+	if coo.IdleConnections != nil {
+		stage = append(stage, bson.E{Key: "idleConnections", Value: *coo.IdleConnections})
+	}
+	pipeline := Pipeline{{{Key: "$currentOp", Value: stage}}}
+	if coo.Filter != nil {
+		pipeline = append(pipeline, bson.D{{Key: "$match", Value: coo.Filter}})
+	}
+
+	cursor, err := db.client.Database("admin").Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+
+	var ops []*OpInfo
+	if err = cursor.All(ctx, &ops); err != nil {
+		return nil, err
+	}
+	return ops, nil
+}
+
 // ReadConcern returns the read concern used to configure the Database object.
 func (db *Database) ReadConcern() *readconcern.ReadConcern {
 	return db.readConcern
@@ -473,6 +532,7 @@ func (db *Database) Watch(ctx context.Context, pipeline interface{},
 		streamType:     DatabaseStream,
 		databaseName:   db.Name(),
 		crypt:          db.client.crypt,
+		timeout:        db.timeout,
 	}
 	return newChangeStream(ctx, csConfig, pipeline, opts...)
 }
@@ -485,6 +545,19 @@ func (db *Database) Watch(ctx context.Context, pipeline interface{},
 // documentation).
 func (db *Database) CreateCollection(ctx context.Context, name string, opts ...*options.CreateCollectionOptions) error {
 	cco := options.MergeCreateCollectionOptions(opts...)
+	op, err := db.createCollectionOperation(name, cco)
+	if err != nil {
+		return err
+	}
+
+	if cco.EncryptedFields != nil {
+		return db.createCollectionWithEncryptedFields(ctx, name, op, cco.EncryptedFields)
+	}
+	return db.executeCreateOperation(ctx, op)
+}
+
+// createCollectionOperation creates the create operation for a collection with the given options.
+func (db *Database) createCollectionOperation(name string, cco *options.CreateCollectionOptions) (*operation.Create, error) {
 	op := operation.NewCreate(name)
 
 	if cco.Capped != nil {
@@ -498,14 +571,14 @@ func (db *Database) CreateCollection(ctx context.Context, name string, opts ...*
 		if cco.DefaultIndexOptions.StorageEngine != nil {
 			storageEngine, err := transformBsoncoreDocument(db.registry, cco.DefaultIndexOptions.StorageEngine)
 			if err != nil {
-				return err
+				return nil, err
 			}
 
 			doc = bsoncore.AppendDocumentElement(doc, "storageEngine", storageEngine)
 		}
 		doc, err := bsoncore.AppendDocumentEnd(doc, idx)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		op.IndexOptionDefaults(doc)
@@ -519,7 +592,7 @@ func (db *Database) CreateCollection(ctx context.Context, name string, opts ...*
 	if cco.StorageEngine != nil {
 		storageEngine, err := transformBsoncoreDocument(db.registry, cco.StorageEngine)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		op.StorageEngine(storageEngine)
 	}
@@ -532,12 +605,48 @@ func (db *Database) CreateCollection(ctx context.Context, name string, opts ...*
 	if cco.Validator != nil {
 		validator, err := transformBsoncoreDocument(db.registry, cco.Validator)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		op.Validator(validator)
 	}
 
-	return db.executeCreateOperation(ctx, op)
+	return op, nil
+}
+
+// createCollectionWithEncryptedFields creates the state collections used by Queryable Encryption, then the collection
+// with the given encryptedFields, then the index on the __safeContent__ field of the collection.
+func (db *Database) createCollectionWithEncryptedFields(ctx context.Context, name string, op *operation.Create,
+	encryptedFields interface{}) error {
+
+	ef, err := transformBsoncoreDocument(db.registry, encryptedFields)
+	if err != nil {
+		return err
+	}
+
+	// The state collections are clustered on _id. Their names can be set in the encryptedFields document.
+	clusteredIndex := bsoncore.NewDocumentBuilder().
+		AppendDocument("key", bsoncore.NewDocumentBuilder().AppendInt32("_id", 1).Build()).
+		AppendBoolean("unique", true).
+		Build()
+	for _, suffix := range []string{"esc", "ecoc"} {
+		stateColl := "enxcol_." + name + "." + suffix
+		if val, err := ef.LookupErr(suffix + "Collection"); err == nil {
+			if str, ok := val.StringValueOK(); ok {
+				stateColl = str
+			}
+		}
+
+		if err = db.executeCreateOperation(ctx, operation.NewCreate(stateColl).ClusteredIndex(clusteredIndex)); err != nil {
+			return err
+		}
+	}
+
+	if err = db.executeCreateOperation(ctx, op.EncryptedFields(ef)); err != nil {
+		return err
+	}
+
+	_, err = db.Collection(name).Indexes().CreateOne(ctx, IndexModel{Keys: bson.D{{Key: "__safeContent__", Value: 1}}})
+	return err
 }
 
 // CreateView executes a create command to explicitly create a view on the server. See
@@ -576,11 +685,11 @@ func (db *Database) executeCreateOperation(ctx context.Context, op *operation.Cr
 	sess := sessionFromContext(ctx)
 	if sess == nil && db.client.sessionPool != nil {
 		var err error
-		sess, err = session.NewClientSession(db.client.sessionPool, db.client.id, session.Implicit)
+		sess, err = db.causalClock.newImplicitSession(db.client)
 		if err != nil {
 			return err
 		}
-		defer sess.EndSession()
+		defer db.causalClock.endImplicitSession(sess)
 	}
 
 	err := db.client.validSession(sess)
@@ -600,7 +709,7 @@ func (db *Database) executeCreateOperation(ctx context.Context, op *operation.Cr
 	op = op.Session(sess).
 		WriteConcern(wc).
 		CommandMonitor(db.client.monitor).
-		ServerSelector(selector).
+		ServerSelector(selector).Timeout(db.timeout).
 		ClusterClock(db.client.clock).
 		Database(db.name).
 		Deployment(db.client.deployment).
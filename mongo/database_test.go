@@ -14,12 +14,54 @@ import (
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/bsoncodec"
 	"go.mongodb.org/mongo-driver/internal/testutil/assert"
+	"go.mongodb.org/mongo-driver/mongo/description"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.mongodb.org/mongo-driver/mongo/readconcern"
 	"go.mongodb.org/mongo-driver/mongo/readpref"
 	"go.mongodb.org/mongo-driver/mongo/writeconcern"
+	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
+	"go.mongodb.org/mongo-driver/x/mongo/driver"
+	"go.mongodb.org/mongo-driver/x/mongo/driver/drivertest"
 )
 
+// commandConnection is a driver.Connection that records the commands written to it and replies {ok: 1} to each.
+type commandConnection struct {
+	*drivertest.ChannelConn
+	commands *[]bsoncore.Document
+}
+
+func (cc commandConnection) WriteWireMessage(_ context.Context, wm []byte) error {
+	cmd, err := drivertest.GetCommandFromQueryWireMessage(wm)
+	if err != nil {
+		return err
+	}
+	*cc.commands = append(*cc.commands, cmd)
+	return nil
+}
+
+func (cc commandConnection) ReadWireMessage(context.Context, []byte) ([]byte, error) {
+	return drivertest.MakeReply(bsoncore.BuildDocument(nil, bsoncore.AppendInt32Element(nil, "ok", 1))), nil
+}
+
+// commandServer is a driver.Server that returns a commandConnection.
+type commandServer struct {
+	conn commandConnection
+}
+
+func (cs commandServer) Connection(context.Context) (driver.Connection, error) {
+	return cs.conn, nil
+}
+
+// commandDeployment is a mockDeployment that selects a commandServer.
+type commandDeployment struct {
+	mockDeployment
+	server commandServer
+}
+
+func (cd commandDeployment) SelectServer(context.Context, description.ServerSelector) (driver.Server, error) {
+	return cd.server, nil
+}
+
 func setupDb(name string, opts ...*options.DatabaseOptions) *Database {
 	client := setupClient()
 	return client.Database(name, opts...)
@@ -110,4 +152,27 @@ func TestDatabase(t *testing.T) {
 		_, err = db.ListCollectionNames(context.Background(), nil)
 		assert.Equal(t, ErrNilDocument, err, "expected error %v, got %v", ErrNilDocument, err)
 	})
+	t.Run("create collection with encrypted fields", func(t *testing.T) {
+		var commands []bsoncore.Document
+		conn := commandConnection{ChannelConn: &drivertest.ChannelConn{}, commands: &commands}
+		client := setupClient(&options.ClientOptions{Deployment: commandDeployment{server: commandServer{conn: conn}}})
+		db := client.Database("foo")
+
+		ef := bson.D{{"escCollection", "customEsc"}, {"fields", bson.A{}}}
+		err := db.CreateCollection(bgCtx, "coll", options.CreateCollection().SetEncryptedFields(ef))
+		assert.Nil(t, err, "CreateCollection error: %v", err)
+
+		var got []string
+		for _, cmd := range commands {
+			elem := cmd.Index(0)
+			got = append(got, elem.Key()+" "+elem.Value().StringValue())
+		}
+		expected := []string{"create customEsc", "create enxcol_.coll.ecoc", "create coll", "createIndexes coll"}
+		assert.Equal(t, expected, got, "expected commands %v, got %v", expected, got)
+
+		_, err = commands[0].LookupErr("clusteredIndex")
+		assert.Nil(t, err, "expected state collection to be clustered, got %v", commands[0])
+		_, err = commands[2].LookupErr("encryptedFields")
+		assert.Nil(t, err, "expected encryptedFields to be sent, got %v", commands[2])
+	})
 }
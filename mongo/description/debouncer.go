@@ -0,0 +1,115 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package description
+
+import (
+	"sync"
+	"time"
+)
+
+// Debouncer filters a stream of topology descriptions so that only meaningful changes are emitted. Two descriptions
+// are considered the same if Topology.Equal reports them as equal, which ignores fields that change on every
+// heartbeat such as the average round trip time of each server.
+//
+// Updates that arrive within the configured window of each other are coalesced, and only the latest description is
+// emitted once the window elapses. If the latest description is equal to the last one emitted, nothing is emitted.
+type Debouncer struct {
+	window time.Duration
+	emit   func(Topology)
+
+	mu      sync.Mutex
+	emitMu  sync.Mutex
+	last    Topology
+	emitted bool
+	pending *Topology
+	timer   *time.Timer
+	stopped bool
+}
+
+// NewDebouncer creates a new Debouncer that calls emit for each meaningful change in the topology descriptions passed
+// to Update. If window is zero or negative, updates are not coalesced and emit is called synchronously from Update.
+func NewDebouncer(window time.Duration, emit func(Topology)) *Debouncer {
+	return &Debouncer{
+		window: window,
+		emit:   emit,
+	}
+}
+
+// Update records a new topology description. The first description passed to Update is always emitted.
+func (d *Debouncer) Update(t Topology) {
+	d.mu.Lock()
+	if d.stopped {
+		d.mu.Unlock()
+		return
+	}
+
+	if d.window <= 0 {
+		changed := d.changed(t)
+		if changed {
+			d.last = t
+			d.emitted = true
+		}
+		d.mu.Unlock()
+
+		if changed {
+			d.emitMu.Lock()
+			d.emit(t)
+			d.emitMu.Unlock()
+		}
+		return
+	}
+
+	d.pending = &t
+	if d.timer == nil {
+		d.timer = time.AfterFunc(d.window, d.flush)
+	}
+	d.mu.Unlock()
+}
+
+// Stop stops the Debouncer. Any pending description that has not been emitted yet is discarded and subsequent calls
+// to Update are ignored.
+func (d *Debouncer) Stop() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.stopped = true
+	d.pending = nil
+	if d.timer != nil {
+		d.timer.Stop()
+		d.timer = nil
+	}
+}
+
+func (d *Debouncer) flush() {
+	d.emitMu.Lock()
+	defer d.emitMu.Unlock()
+
+	d.mu.Lock()
+	d.timer = nil
+	if d.stopped || d.pending == nil {
+		d.mu.Unlock()
+		return
+	}
+
+	t := *d.pending
+	d.pending = nil
+	changed := d.changed(t)
+	if changed {
+		d.last = t
+		d.emitted = true
+	}
+	d.mu.Unlock()
+
+	if changed {
+		d.emit(t)
+	}
+}
+
+// changed returns true if t should be emitted. d.mu must be held when calling this method.
+func (d *Debouncer) changed(t Topology) bool {
+	return !d.emitted || !d.last.Equal(t)
+}
@@ -0,0 +1,67 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package description
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDebouncer(t *testing.T) {
+	primary := Server{Addr: "1.0.0.0:27017", Kind: RSPrimary}
+	secondary := Server{Addr: "2.0.0.0:27017", Kind: RSSecondary}
+
+	t.Run("RTT changes are not emitted", func(t *testing.T) {
+		var emitted []Topology
+		d := NewDebouncer(0, func(topo Topology) { emitted = append(emitted, topo) })
+
+		d.Update(Topology{Kind: ReplicaSetWithPrimary, Servers: []Server{primary}})
+		d.Update(Topology{Kind: ReplicaSetWithPrimary, Servers: []Server{primary.SetAverageRTT(time.Millisecond)}})
+		d.Update(Topology{Kind: ReplicaSetWithPrimary, Servers: []Server{primary, secondary}})
+		assert.Len(t, emitted, 2)
+		assert.Len(t, emitted[1].Servers, 2)
+	})
+	t.Run("updates within window are coalesced", func(t *testing.T) {
+		emitted := make(chan Topology, 10)
+		d := NewDebouncer(50*time.Millisecond, func(topo Topology) { emitted <- topo })
+		defer d.Stop()
+
+		d.Update(Topology{Kind: ReplicaSetNoPrimary})
+		d.Update(Topology{Kind: ReplicaSetWithPrimary, Servers: []Server{primary}})
+		d.Update(Topology{Kind: ReplicaSetWithPrimary, Servers: []Server{primary, secondary}})
+
+		select {
+		case topo := <-emitted:
+			assert.Len(t, topo.Servers, 2)
+		case <-time.After(time.Second):
+			require.Fail(t, "timed out waiting for topology to be emitted")
+		}
+
+		// An update equal to the last emitted description should not be emitted once the window elapses.
+		d.Update(Topology{Kind: ReplicaSetWithPrimary, Servers: []Server{primary, secondary}})
+		select {
+		case topo := <-emitted:
+			assert.Fail(t, "unexpected topology emitted", "%v", topo)
+		case <-time.After(150 * time.Millisecond):
+		}
+	})
+	t.Run("stop discards pending updates", func(t *testing.T) {
+		emitted := make(chan Topology, 10)
+		d := NewDebouncer(50*time.Millisecond, func(topo Topology) { emitted <- topo })
+
+		d.Update(Topology{Kind: Single})
+		d.Stop()
+		select {
+		case topo := <-emitted:
+			assert.Fail(t, "unexpected topology emitted", "%v", topo)
+		case <-time.After(150 * time.Millisecond):
+		}
+	})
+}
@@ -683,6 +683,34 @@ func TestSelector_Nearest_with_maxStaleness(t *testing.T) {
 	require.Equal([]Server{readPrefTestPrimary, readPrefTestSecondary2}, result)
 }
 
+func TestSelector_Nearest_with_maxStaleness_filters_before_latency(t *testing.T) {
+	t.Parallel()
+
+	require := require.New(t)
+	subject := readpref.Nearest(
+		readpref.WithMaxStaleness(time.Duration(90) * time.Second),
+	)
+
+	// The stale secondary has the lowest RTT, so it would be the only server in the latency window if the staleness
+	// filter was not applied first.
+	primary := readPrefTestPrimary.SetAverageRTT(20 * time.Millisecond)
+	staleSecondary := readPrefTestSecondary1.SetAverageRTT(1 * time.Millisecond)
+	secondary := readPrefTestSecondary2.SetAverageRTT(25 * time.Millisecond)
+	topo := Topology{
+		Kind:    ReplicaSetWithPrimary,
+		Servers: []Server{primary, staleSecondary, secondary},
+	}
+	selector := CompositeSelector([]ServerSelector{
+		ReadPrefSelector(subject),
+		LatencySelector(15 * time.Millisecond),
+	})
+
+	result, err := selector.SelectServer(topo, topo.Servers)
+
+	require.NoError(err)
+	require.Equal([]Server{primary, secondary}, result)
+}
+
 func TestSelector_Nearest_with_maxStaleness_and_no_primary(t *testing.T) {
 	t.Parallel()
 
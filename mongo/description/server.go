@@ -57,6 +57,17 @@ type Server struct {
 	TopologyVersion       *TopologyVersion
 	Kind                  ServerKind
 	WireVersion           *VersionRange
+
+	// RejectsWrites is true if the server's hello response indicated that it is rejecting writes. A server that
+	// reports readOnly, such as a primary that has been put into a read-only state for maintenance, rejects writes and
+	// is not considered writable by Topology.HasWritableServer.
+	RejectsWrites bool
+
+	// HelloResponse is the full response to the most recent hello or isMaster command sent to the server by the
+	// monitor. It is nil if the server has not been checked successfully. It is not compared by Equal because it
+	// contains fields such as localTime that change on every check. The response does not include the server version;
+	// run the buildInfo command to get it.
+	HelloResponse bson.Raw
 }
 
 // NewServer creates a new server description from the given parameters.
@@ -67,6 +78,7 @@ func NewServer(addr address.Address, response bson.Raw) Server {
 		desc.LastError = err
 		return desc
 	}
+	desc.HelloResponse = append(bson.Raw(nil), response...)
 	var ok bool
 	var isReplicaSet, isMaster, hidden, secondary, arbiterOnly bool
 	var msg string
@@ -296,6 +308,7 @@ func NewServer(addr address.Address, response bson.Raw) Server {
 	}
 
 	desc.WireVersion = &version
+	desc.RejectsWrites = desc.ReadOnly
 
 	return desc
 }
@@ -335,6 +348,12 @@ func (s Server) DataBearing() bool {
 		s.Kind == Standalone
 }
 
+// AcceptsWrites returns true if the server's hello response did not indicate that it is rejecting writes. It does not
+// consider the server's kind; use Topology.HasWritableServer to check whether a topology can accept writes.
+func (s Server) AcceptsWrites() bool {
+	return !s.RejectsWrites
+}
+
 // SelectServer selects this server if it is in the list of given candidates.
 func (s Server) SelectServer(_ Topology, candidates []Server) ([]Server, error) {
 	for _, candidate := range candidates {
@@ -389,68 +408,77 @@ func (s Server) SupportsRetryWrites() bool {
 
 // Equal compares two server descriptions and returns true if they are equal
 func (s Server) Equal(other Server) bool {
+	return len(s.Diff(other)) == 0
+}
+
+// Diff compares two server descriptions and returns the names of the fields that differ, in the order they are
+// compared. Only the fields considered by Equal are compared, so a nil result means the descriptions are equal.
+func (s Server) Diff(other Server) []string {
+	var diff []string
+
 	if s.CanonicalAddr.String() != other.CanonicalAddr.String() {
-		return false
+		diff = append(diff, "CanonicalAddr")
 	}
 
 	if !sliceStringEqual(s.Arbiters, other.Arbiters) {
-		return false
+		diff = append(diff, "Arbiters")
 	}
 
 	if !sliceStringEqual(s.Hosts, other.Hosts) {
-		return false
+		diff = append(diff, "Hosts")
 	}
 
 	if !sliceStringEqual(s.Passives, other.Passives) {
-		return false
+		diff = append(diff, "Passives")
 	}
 
 	if s.Primary != other.Primary {
-		return false
+		diff = append(diff, "Primary")
 	}
 
 	if s.SetName != other.SetName {
-		return false
+		diff = append(diff, "SetName")
 	}
 
 	if s.Kind != other.Kind {
-		return false
+		diff = append(diff, "Kind")
+	}
+
+	if s.RejectsWrites != other.RejectsWrites {
+		diff = append(diff, "RejectsWrites")
 	}
 
 	if s.LastError != nil || other.LastError != nil {
-		if s.LastError == nil || other.LastError == nil {
-			return false
-		}
-		if s.LastError.Error() != other.LastError.Error() {
-			return false
+		if s.LastError == nil || other.LastError == nil || s.LastError.Error() != other.LastError.Error() {
+			diff = append(diff, "LastError")
 		}
 	}
 
 	if !s.WireVersion.Equals(other.WireVersion) {
-		return false
+		diff = append(diff, "WireVersion")
 	}
 
 	if len(s.Tags) != len(other.Tags) || !s.Tags.ContainsAll(other.Tags) {
-		return false
+		diff = append(diff, "Tags")
 	}
 
 	if s.SetVersion != other.SetVersion {
-		return false
+		diff = append(diff, "SetVersion")
 	}
 
 	if s.ElectionID != other.ElectionID {
-		return false
+		diff = append(diff, "ElectionID")
 	}
 
 	if s.SessionTimeoutMinutes != other.SessionTimeoutMinutes {
-		return false
+		diff = append(diff, "SessionTimeoutMinutes")
 	}
 
 	if s.TopologyVersion != other.TopologyVersion && CompareTopologyVersion(s.TopologyVersion, other.TopologyVersion) != 0 {
-		return false
+		diff = append(diff, "TopologyVersion")
 	}
 
-	return true
+	return diff
 }
 
 func sliceStringEqual(a []string, b []string) bool {
@@ -6,6 +6,8 @@
 
 package description
 
+import "fmt"
+
 // ServerKind represents the type of a server.
 type ServerKind uint32
 
@@ -41,3 +43,38 @@ func (kind ServerKind) String() string {
 
 	return "Unknown"
 }
+
+// IsAvailable returns true if the kind is a known server kind other than Unknown.
+func (kind ServerKind) IsAvailable() bool {
+	switch kind {
+	case Standalone, RSMember, RSPrimary, RSSecondary, RSArbiter, RSGhost, Mongos:
+		return true
+	}
+
+	return false
+}
+
+// ParseServerKind parses a ServerKind from its string representation. It is the inverse of ServerKind.String. An
+// error is returned if s is not a valid server kind.
+func ParseServerKind(s string) (ServerKind, error) {
+	switch s {
+	case "Standalone":
+		return Standalone, nil
+	case "RSOther":
+		return RSMember, nil
+	case "RSPrimary":
+		return RSPrimary, nil
+	case "RSSecondary":
+		return RSSecondary, nil
+	case "RSArbiter":
+		return RSArbiter, nil
+	case "RSGhost":
+		return RSGhost, nil
+	case "Mongos":
+		return Mongos, nil
+	case "Unknown":
+		return Unknown, nil
+	}
+
+	return Unknown, fmt.Errorf("invalid server kind: %q", s)
+}
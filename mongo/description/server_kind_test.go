@@ -0,0 +1,46 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package description
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestServerKind(t *testing.T) {
+	t.Parallel()
+
+	kinds := []struct {
+		kind      ServerKind
+		available bool
+	}{
+		{Standalone, true},
+		{RSMember, true},
+		{RSPrimary, true},
+		{RSSecondary, true},
+		{RSArbiter, true},
+		{RSGhost, true},
+		{Mongos, true},
+		{Unknown, false},
+	}
+
+	for _, test := range kinds {
+		t.Run(test.kind.String(), func(t *testing.T) {
+			parsed, err := ParseServerKind(test.kind.String())
+			require.NoError(t, err)
+			require.Equal(t, test.kind, parsed)
+			require.Equal(t, test.available, test.kind.IsAvailable())
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		kind, err := ParseServerKind("PossiblePrimary")
+		require.Error(t, err)
+		require.Equal(t, ServerKind(Unknown), kind)
+	})
+}
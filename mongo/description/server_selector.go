@@ -168,6 +168,8 @@ func selectForReplicaSet(rp *readpref.ReadPref, t Topology, candidates []Server)
 		selected := selectSecondaries(rp, candidates)
 		return selectByTagSet(selected, rp.TagSets()), nil
 	case readpref.NearestMode:
+		// The staleness filter in selectSecondaries runs here, before the LatencySelector that follows this selector,
+		// so a stale secondary cannot be chosen just because it has the lowest round trip time.
 		selected := selectByKind(candidates, RSPrimary)
 		selected = append(selected, selectSecondaries(rp, candidates)...)
 		return selectByTagSet(selected, rp.TagSets()), nil
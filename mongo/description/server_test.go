@@ -11,10 +11,12 @@ import (
 	"testing"
 	"time"
 
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/internal/testutil/assert"
 	"go.mongodb.org/mongo-driver/mongo/address"
 	"go.mongodb.org/mongo-driver/tag"
+	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
 )
 
 func TestServer(t *testing.T) {
@@ -45,6 +47,7 @@ func TestServer(t *testing.T) {
 			{"passives", Server{Passives: []string{"foo"}}, false},
 			{"primary", Server{Primary: address.Address("foo")}, false},
 			{"readOnly", Server{ReadOnly: true}, true},
+			{"rejectsWrites", Server{RejectsWrites: true}, false},
 			{"sessionTimeoutMinutes", Server{SessionTimeoutMinutes: 1}, false},
 			{"setName", Server{SetName: "foo"}, false},
 			{"setVersion", Server{SetVersion: 1}, false},
@@ -60,4 +63,35 @@ func TestServer(t *testing.T) {
 			})
 		}
 	})
+	t.Run("diff", func(t *testing.T) {
+		s1 := Server{Kind: RSPrimary, SetName: "rs", AverageRTT: time.Second}
+		s2 := Server{Kind: RSSecondary, SetName: "rs", SetVersion: 2}
+
+		diff := s1.Diff(s2)
+		expected := []string{"Kind", "SetVersion"}
+		assert.Equal(t, expected, diff, "expected diff %v, got %v", expected, diff)
+
+		diff = s1.Diff(s1)
+		assert.Equal(t, 0, len(diff), "expected no diff, got %v", diff)
+	})
+	t.Run("hello response", func(t *testing.T) {
+		response := bson.Raw(bsoncore.NewDocumentBuilder().AppendInt32("ok", 1).AppendBoolean("ismaster", true).Build())
+		checked := NewServer("a:27017", response)
+		assert.Equal(t, response, checked.HelloResponse, "expected hello response %v, got %v", response,
+			checked.HelloResponse)
+
+		unchecked := NewDefaultServer("b:27017")
+		assert.Nil(t, unchecked.HelloResponse, "expected nil hello response, got %v", unchecked.HelloResponse)
+	})
+	t.Run("accepts writes", func(t *testing.T) {
+		primary := NewServer("a:27017", bson.Raw(bsoncore.NewDocumentBuilder().
+			AppendInt32("ok", 1).AppendBoolean("ismaster", true).Build()))
+		assert.True(t, primary.AcceptsWrites(), "expected primary to accept writes")
+
+		readOnly := NewServer("b:27017", bson.Raw(bsoncore.NewDocumentBuilder().
+			AppendInt32("ok", 1).AppendBoolean("ismaster", true).AppendBoolean("readOnly", true).Build()))
+		assert.False(t, readOnly.AcceptsWrites(), "expected read-only server to reject writes")
+
+		assert.True(t, Server{}.AcceptsWrites(), "expected zero value to accept writes")
+	})
 }
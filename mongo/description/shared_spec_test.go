@@ -78,24 +78,8 @@ func topologyKindFromString(s string) TopologyKind {
 }
 
 func serverKindFromString(s string) ServerKind {
-	switch s {
-	case "Standalone":
-		return Standalone
-	case "RSOther":
-		return RSMember
-	case "RSPrimary":
-		return RSPrimary
-	case "RSSecondary":
-		return RSSecondary
-	case "RSArbiter":
-		return RSArbiter
-	case "RSGhost":
-		return RSGhost
-	case "Mongos":
-		return Mongos
-	}
-
-	return Unknown
+	kind, _ := ParseServerKind(s)
+	return kind
 }
 
 func findServerByAddress(servers []Server, address string) Server {
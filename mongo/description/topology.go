@@ -8,6 +8,7 @@ package description
 
 import (
 	"fmt"
+	"sort"
 
 	"go.mongodb.org/mongo-driver/mongo/address"
 	"go.mongodb.org/mongo-driver/mongo/readpref"
@@ -22,6 +23,16 @@ type Topology struct {
 	CompatibilityErr      error
 }
 
+// Clone returns a copy of the topology description with its own list of servers, so that servers can be added,
+// removed, or replaced in the copy without affecting t. The server descriptions are copied by value and any slices
+// they contain are shared with t.
+func (t Topology) Clone() Topology {
+	if t.Servers != nil {
+		t.Servers = append([]Server(nil), t.Servers...)
+	}
+	return t
+}
+
 // Server returns the server for the given address. Returns false if the server
 // could not be found.
 func (t Topology) Server(addr address.Address) (Server, bool) {
@@ -97,10 +108,17 @@ func (t Topology) DiffHostlist(hostlist []string) HostlistDiff {
 	return diff
 }
 
-// String implements the Stringer interface
+// String implements the Stringer interface. Servers are rendered in order of their canonical address so the output is
+// deterministic. The order of t.Servers is not modified.
 func (t Topology) String() string {
+	servers := make([]Server, len(t.Servers))
+	copy(servers, t.Servers)
+	sort.SliceStable(servers, func(i, j int) bool {
+		return servers[i].Addr.Canonicalize() < servers[j].Addr.Canonicalize()
+	})
+
 	var serversStr string
-	for _, s := range t.Servers {
+	for _, s := range servers {
 		serversStr += "{ " + s.String() + " }, "
 	}
 	return fmt.Sprintf("Type: %s, Servers: [%s]", t.Kind, serversStr)
@@ -143,6 +161,30 @@ func (t Topology) Equal(other Topology) bool {
 	return true
 }
 
+// Diff compares the servers present in both topology descriptions and returns the names of the fields that differ
+// for each server, keyed by server address. Servers whose descriptions are equal are omitted. Servers that were added
+// or removed are reported by DiffTopology instead.
+func (t Topology) Diff(other Topology) map[string][]string {
+	otherServers := make(map[string]Server, len(other.Servers))
+	for _, s := range other.Servers {
+		otherServers[s.Addr.String()] = s
+	}
+
+	diffs := make(map[string][]string)
+	for _, s := range t.Servers {
+		addr := s.Addr.String()
+		otherServer, ok := otherServers[addr]
+		if !ok {
+			continue
+		}
+		if diff := s.Diff(otherServer); len(diff) > 0 {
+			diffs[addr] = diff
+		}
+	}
+
+	return diffs
+}
+
 // HasReadableServer returns true if a topology has a server available for reading
 // based on the specified read preference. Single and sharded topologies only require an
 // available server, while replica sets require an available server that has a kind
@@ -167,9 +209,34 @@ func (t Topology) HasReadableServer(mode readpref.Mode) bool {
 	return false
 }
 
-// HasWritableServer returns true if a topology has a server available for writing
+// HasWritableServer returns true if a topology has a server available for writing. Servers that reject writes are not
+// considered writable.
 func (t Topology) HasWritableServer() bool {
-	return t.HasReadableServer(readpref.PrimaryMode)
+	return t.WritableServerCount() > 0
+}
+
+// WritableServerCount returns the number of servers in the topology that are available for writing. Single and
+// sharded topologies count every available server, while replica sets only count the primary. Servers that reject
+// writes are not counted.
+func (t Topology) WritableServerCount() int {
+	var count int
+	for _, s := range t.Servers {
+		if !s.AcceptsWrites() {
+			continue
+		}
+
+		switch t.Kind {
+		case Single, Sharded:
+			if s.Kind.IsAvailable() {
+				count++
+			}
+		case ReplicaSetWithPrimary:
+			if s.Kind == RSPrimary {
+				count++
+			}
+		}
+	}
+	return count
 }
 
 // hasAvailableServer returns true if any servers are available based on
@@ -201,14 +268,7 @@ func hasAvailableServer(servers []Server, mode readpref.Mode) bool {
 
 	// read preference is not specified
 	for _, s := range servers {
-		switch s.Kind {
-		case Standalone,
-			RSMember,
-			RSPrimary,
-			RSSecondary,
-			RSArbiter,
-			RSGhost,
-			Mongos:
+		if s.Kind.IsAvailable() {
 			return true
 		}
 	}
@@ -0,0 +1,34 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package description
+
+import (
+	"sync/atomic"
+)
+
+// TopologyStore holds the latest topology description and allows it to be read and replaced concurrently without
+// locks. Descriptions are cloned when they are stored and when they are loaded, so a reader always sees a complete
+// description and changes made by one reader to the returned server list are not visible to other readers.
+//
+// The zero value is ready to use and holds an empty description.
+type TopologyStore struct {
+	v atomic.Value // holds a Topology
+}
+
+// Store replaces the stored description with a copy of t.
+func (ts *TopologyStore) Store(t Topology) {
+	ts.v.Store(t.Clone())
+}
+
+// Load returns a copy of the most recently stored description, or an empty description if none has been stored.
+func (ts *TopologyStore) Load() Topology {
+	t, ok := ts.v.Load().(Topology)
+	if !ok {
+		return Topology{}
+	}
+	return t.Clone()
+}
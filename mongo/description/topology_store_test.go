@@ -0,0 +1,66 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package description
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTopologyStore(t *testing.T) {
+	primary := Server{Addr: "1.0.0.0:27017", Kind: RSPrimary}
+	secondary := Server{Addr: "2.0.0.0:27017", Kind: RSSecondary}
+
+	t.Run("zero value", func(t *testing.T) {
+		var ts TopologyStore
+		assert.Equal(t, Topology{}, ts.Load())
+	})
+	t.Run("load returns a copy", func(t *testing.T) {
+		var ts TopologyStore
+		topo := Topology{Kind: ReplicaSetWithPrimary, Servers: []Server{primary, secondary}}
+		ts.Store(topo)
+
+		// Modifying the stored or loaded server lists must not affect the description held by the store.
+		topo.Servers[0] = secondary
+		loaded := ts.Load()
+		assert.Equal(t, primary, loaded.Servers[0])
+		loaded.Servers[1] = primary
+		assert.Equal(t, secondary, ts.Load().Servers[1])
+	})
+	t.Run("concurrent access", func(t *testing.T) {
+		var ts TopologyStore
+		snapshots := []Topology{
+			{Kind: ReplicaSetNoPrimary, Servers: []Server{secondary}},
+			{Kind: ReplicaSetWithPrimary, Servers: []Server{primary, secondary}},
+		}
+
+		var wg sync.WaitGroup
+		for i := 0; i < 4; i++ {
+			wg.Add(2)
+			go func(i int) {
+				defer wg.Done()
+				for j := 0; j < 100; j++ {
+					ts.Store(snapshots[(i+j)%len(snapshots)])
+				}
+			}(i)
+			go func() {
+				defer wg.Done()
+				for j := 0; j < 100; j++ {
+					topo := ts.Load()
+					if topo.Kind == Unknown {
+						continue
+					}
+					// Each loaded description must match one of the stored snapshots in full.
+					assert.Contains(t, snapshots, topo)
+				}
+			}()
+		}
+		wg.Wait()
+	})
+}
@@ -10,6 +10,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
 )
 
 func TestDiffTopology(t *testing.T) {
@@ -37,6 +39,26 @@ func TestDiffTopology(t *testing.T) {
 	assert.EqualValues(t, []Server{s2, s4, s3, s5}, t2.Servers)
 }
 
+func TestTopology_Diff(t *testing.T) {
+	t1 := Topology{
+		Servers: []Server{
+			{Addr: "1.0.0.0:27017", Kind: RSPrimary},
+			{Addr: "2.0.0.0:27017", Kind: RSSecondary},
+			{Addr: "3.0.0.0:27017", Kind: RSSecondary},
+		},
+	}
+	t2 := Topology{
+		Servers: []Server{
+			{Addr: "1.0.0.0:27017", Kind: RSSecondary, SetVersion: 2},
+			{Addr: "2.0.0.0:27017", Kind: RSSecondary},
+			{Addr: "4.0.0.0:27017", Kind: RSPrimary},
+		},
+	}
+
+	diff := t1.Diff(t2)
+	assert.Equal(t, map[string][]string{"1.0.0.0:27017": {"Kind", "SetVersion"}}, diff)
+}
+
 func TestTopology_DiffHostlist(t *testing.T) {
 	h1 := "1.0.0.0:27017"
 	h2 := "2.0.0.0:27017"
@@ -63,3 +85,42 @@ func TestTopology_DiffHostlist(t *testing.T) {
 	assert.EqualValues(t, []Server{s6, s1, s3, s2}, topo.Servers)
 	assert.EqualValues(t, []string{h2, h4, h3, h5}, hostlist)
 }
+
+func TestTopology_String(t *testing.T) {
+	s1 := Server{Addr: "a.example.com:27017"}
+	s2 := Server{Addr: "B.example.com:27017"}
+	s3 := Server{Addr: "c.example.com:27017"}
+
+	t1 := Topology{Kind: ReplicaSetNoPrimary, Servers: []Server{s3, s1, s2}}
+	t2 := Topology{Kind: ReplicaSetNoPrimary, Servers: []Server{s2, s3, s1}}
+
+	assert.Equal(t, t1.String(), t2.String())
+	assert.Regexp(t, `a\.example\.com.*b\.example\.com.*c\.example\.com`, t1.String())
+
+	// Ensure that the original topology servers were not reordered.
+	assert.EqualValues(t, []Server{s3, s1, s2}, t1.Servers)
+}
+
+func TestTopology_WritableServerCount(t *testing.T) {
+	primary := NewServer("a:27017", bson.Raw(bsoncore.NewDocumentBuilder().
+		AppendInt32("ok", 1).AppendBoolean("ismaster", true).AppendString("setName", "rs").Build()))
+	readOnlyPrimary := NewServer("b:27017", bson.Raw(bsoncore.NewDocumentBuilder().
+		AppendInt32("ok", 1).AppendBoolean("ismaster", true).AppendString("setName", "rs").
+		AppendBoolean("readOnly", true).Build()))
+	secondary := NewServer("c:27017", bson.Raw(bsoncore.NewDocumentBuilder().
+		AppendInt32("ok", 1).AppendBoolean("secondary", true).AppendString("setName", "rs").Build()))
+
+	assert.False(t, primary.RejectsWrites)
+	assert.True(t, readOnlyPrimary.RejectsWrites)
+
+	topo := Topology{Kind: ReplicaSetWithPrimary, Servers: []Server{primary, secondary}}
+	assert.Equal(t, 1, topo.WritableServerCount())
+	assert.True(t, topo.HasWritableServer())
+
+	topo = Topology{Kind: ReplicaSetWithPrimary, Servers: []Server{readOnlyPrimary, secondary}}
+	assert.Equal(t, 0, topo.WritableServerCount())
+	assert.False(t, topo.HasWritableServer())
+
+	topo = Topology{Kind: ReplicaSetNoPrimary, Servers: []Server{secondary}}
+	assert.False(t, topo.HasWritableServer())
+}
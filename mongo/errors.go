@@ -29,9 +29,16 @@ var ErrNilDocument = errors.New("document is nil")
 // ErrNilValue is returned when a nil value is passed to a CRUD method.
 var ErrNilValue = errors.New("value is nil")
 
+// ErrInvalidHint is returned if a hint is neither an index name string nor an index specification document.
+var ErrInvalidHint = errors.New("hint must be an index name string or an index specification document")
+
 // ErrEmptySlice is returned when an empty slice is passed to a CRUD method that requires a non-empty slice.
 var ErrEmptySlice = errors.New("must provide at least one element in input slice")
 
+// ErrReadConcernInTransaction is returned when a read concern is specified for an individual operation that is run in
+// a transaction.
+var ErrReadConcernInTransaction = errors.New("cannot set read concern for an operation in a transaction")
+
 func replaceErrors(err error) error {
 	if err == topology.ErrTopologyClosed {
 		return ErrClientDisconnected
@@ -207,8 +214,14 @@ func (wce WriteConcernError) Error() string {
 
 // WriteException is the error type returned by the InsertOne, DeleteOne, DeleteMany, UpdateOne, UpdateMany, and
 // ReplaceOne operations.
+//
+// A WriteException can contain write errors, a write concern error, or both. Write errors indicate that the server
+// rejected the write for one or more documents. A write concern error without write errors indicates that the write
+// was applied on the server it was sent to but could not be acknowledged according to the requested write concern
+// (e.g. because wtimeout expired before the write was replicated), so retrying the write may apply it twice.
 type WriteException struct {
-	// The write concern error that occurred, or nil if there was none.
+	// The write concern error that occurred, or nil if there was none. The error code and message reported by the
+	// server are available through the Code and Message fields.
 	WriteConcernError *WriteConcernError
 
 	// The write errors that occurred during operation execution.
@@ -227,6 +240,11 @@ func (mwe WriteException) Error() string {
 	return buf.String()
 }
 
+// HasWriteConcernError returns true if the server reported a write concern error for the operation.
+func (mwe WriteException) HasWriteConcernError() bool {
+	return mwe.WriteConcernError != nil
+}
+
 // HasErrorLabel returns true if the error contains the specified label.
 func (mwe WriteException) HasErrorLabel(label string) bool {
 	if mwe.Labels != nil {
@@ -299,6 +317,32 @@ func (bwe BulkWriteException) HasErrorLabel(label string) bool {
 	return false
 }
 
+// IsNotWritablePrimary returns true if err indicates that the server the operation was sent to is not a writable
+// primary, which happens when a write is sent to a primary that stepped down during a failover. This is narrower than
+// the RetryableWriteError label, which is also added for network errors and other transient server states.
+//
+// When the driver receives one of these errors, it marks the server as Unknown and immediately checks it again rather
+// than waiting for the next heartbeat, so an operation retried after this error will select the newly elected primary
+// as soon as it has been discovered.
+func IsNotWritablePrimary(err error) bool {
+	switch e := err.(type) {
+	case CommandError:
+		return driver.Error{Code: e.Code, Message: e.Message}.NotMaster()
+	case WriteException:
+		return e.WriteConcernError.notWritablePrimary()
+	case BulkWriteException:
+		return e.WriteConcernError.notWritablePrimary()
+	}
+	return false
+}
+
+func (wce *WriteConcernError) notWritablePrimary() bool {
+	if wce == nil {
+		return false
+	}
+	return driver.WriteConcernError{Code: int64(wce.Code), Message: wce.Message}.NotMaster()
+}
+
 // returnResult is used to determine if a function calling processWriteError should return
 // the result or return nil. Since the processWriteError function is used by many different
 // methods, both *One and *Many, we need a way to differentiate if the method should return
@@ -0,0 +1,57 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"errors"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/internal/testutil/assert"
+)
+
+func TestIsNotWritablePrimary(t *testing.T) {
+	testCases := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil", nil, false},
+		{"other error", errors.New("not master"), false},
+		{"command error code", CommandError{Code: 10107, Message: "not primary"}, true},
+		{"command error message", CommandError{Code: 1, Message: "not master"}, true},
+		{"node is recovering", CommandError{Code: 11602, Message: "interrupted due to stepdown"}, false},
+		{"write concern error", WriteException{WriteConcernError: &WriteConcernError{Code: 10107}}, true},
+		{"write errors only", WriteException{WriteErrors: WriteErrors{{Code: 11000}}}, false},
+		{"bulk write concern error", BulkWriteException{WriteConcernError: &WriteConcernError{Code: 13435}}, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := IsNotWritablePrimary(tc.err)
+			assert.Equal(t, tc.want, got, "expected IsNotWritablePrimary to return %v, got %v", tc.want, got)
+		})
+	}
+}
+
+func TestWriteExceptionHasWriteConcernError(t *testing.T) {
+	wce := &WriteConcernError{Name: "WriteConcernFailed", Code: 64, Message: "waiting for replication timed out"}
+	testCases := []struct {
+		name string
+		we   WriteException
+		want bool
+	}{
+		{"none", WriteException{}, false},
+		{"write errors only", WriteException{WriteErrors: WriteErrors{{Code: 11000}}}, false},
+		{"write concern error only", WriteException{WriteConcernError: wce}, true},
+		{"both", WriteException{WriteConcernError: wce, WriteErrors: WriteErrors{{Code: 11000}}}, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := tc.we.HasWriteConcernError()
+			assert.Equal(t, tc.want, got, "expected HasWriteConcernError to return %v, got %v", tc.want, got)
+		})
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
+)
+
+// ErrExplainWouldWrite is returned by ExplainAggregate if a pipeline containing a $out or $merge stage is explained
+// with a verbosity that would cause the server to execute the pipeline and write its output.
+var ErrExplainWouldWrite = errors.New("pipelines with $out or $merge stages can only be explained with the queryPlanner verbosity")
+
+// ExplainResult is the result of an explain command.
+type ExplainResult struct {
+	// Raw is the full explain output returned by the server.
+	Raw bson.Raw
+}
+
+// ExplainAggregate runs the explain command for an aggregate against the collection and returns the explain output.
+// The pipeline parameter has the same requirements as the pipeline parameter for Aggregate. The verbosity parameter
+// must be one of "queryPlanner", "executionStats", or "allPlansExecution". If it is empty, the server default is used.
+// Per-stage execution times are only reported by the server for the "executionStats" and "allPlansExecution"
+// verbosities and can be retrieved with ExplainResult.StageTimings.
+//
+// The explain command is sent using the collection's read preference. Pipelines containing $out or $merge stages are
+// not executed by the server when explained with the "queryPlanner" verbosity, so explain can be used to validate such
+// a pipeline without writing any documents. Because the other verbosities would execute the pipeline, ExplainAggregate
+// returns ErrExplainWouldWrite without contacting the server if the pipeline ends in $out or $merge and verbosity is
+// not "queryPlanner". An empty verbosity is also rejected in this case because the server default is
+// "allPlansExecution".
+func (coll *Collection) ExplainAggregate(ctx context.Context, pipeline interface{}, verbosity string) (*ExplainResult, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	pipelineArr, hasOutputStage, err := transformAggregatePipelinev2(coll.registry, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	if hasOutputStage && verbosity != "queryPlanner" {
+		return nil, ErrExplainWouldWrite
+	}
+
+	aggCmd := bsoncore.BuildDocument(nil,
+		bsoncore.AppendStringElement(nil, "aggregate", coll.name),
+		bsoncore.AppendArrayElement(nil, "pipeline", pipelineArr),
+		bsoncore.AppendDocumentElement(nil, "cursor", bsoncore.BuildDocument(nil)),
+	)
+	elems := [][]byte{bsoncore.AppendDocumentElement(nil, "explain", aggCmd)}
+	if verbosity != "" {
+		elems = append(elems, bsoncore.AppendStringElement(nil, "verbosity", verbosity))
+	}
+	cmd := bsoncore.BuildDocument(nil, elems...)
+
+	runOpts := options.RunCmd().SetReadPreference(coll.readPreference)
+	raw, err := coll.db.RunCommand(ctx, bson.Raw(cmd), runOpts).DecodeBytes()
+	if err != nil {
+		return nil, err
+	}
+	return &ExplainResult{Raw: raw}, nil
+}
+
+// StageTimings parses the explain output and returns the estimated execution time of each stage, keyed by stage
+// name. Aggregation stages (e.g. "$cursor" or "$group") are reported with the executionTimeMillisEstimate of the stage,
+// and query execution stages (e.g. "IXSCAN" or "FETCH") found in executionStats are reported with their own
+// estimates. For sharded clusters, stage names are prefixed with the name of the shard followed by "/". If the same
+// stage name occurs more than once, subsequent occurrences are suffixed with "#2", "#3", and so on, in the order they
+// appear in the explain output.
+//
+// An error is returned if the explain output does not contain any per-stage execution times, which is the case if
+// the explain was run with the "queryPlanner" verbosity.
+func (er *ExplainResult) StageTimings() (map[string]time.Duration, error) {
+	if err := er.Raw.Validate(); err != nil {
+		return nil, err
+	}
+
+	timings := make(map[string]time.Duration)
+	addExplainTimings(timings, "", er.Raw)
+	if len(timings) == 0 {
+		return nil, errors.New("explain output does not contain per-stage execution times; the executionStats or " +
+			"allPlansExecution verbosity is required")
+	}
+	return timings, nil
+}
+
+// addExplainTimings adds the timings of the aggregation stages, shards, and query execution stages found in doc.
+func addExplainTimings(timings map[string]time.Duration, prefix string, doc bson.Raw) {
+	if stages, ok := doc.Lookup("stages").ArrayOK(); ok {
+		vals, _ := stages.Values()
+		for _, val := range vals {
+			stage, ok := val.DocumentOK()
+			if !ok {
+				continue
+			}
+			elems, _ := stage.Elements()
+			if len(elems) == 0 {
+				continue
+			}
+			name := elems[0].Key()
+			if ms, ok := stage.Lookup("executionTimeMillisEstimate").AsInt64OK(); ok {
+				addStageTiming(timings, prefix+name, ms)
+			}
+			if cursorDoc, ok := elems[0].Value().DocumentOK(); ok && name == "$cursor" {
+				addExplainTimings(timings, prefix, cursorDoc)
+			}
+		}
+	}
+
+	if shards, ok := doc.Lookup("shards").DocumentOK(); ok {
+		elems, _ := shards.Elements()
+		for _, elem := range elems {
+			if shardDoc, ok := elem.Value().DocumentOK(); ok {
+				addExplainTimings(timings, prefix+elem.Key()+"/", shardDoc)
+			}
+		}
+	}
+
+	if plan, ok := doc.Lookup("executionStats", "executionStages").DocumentOK(); ok {
+		addPlanTimings(timings, prefix, plan)
+	}
+}
+
+// addPlanTimings adds the timing of the query execution stage described by plan and all of its input stages.
+func addPlanTimings(timings map[string]time.Duration, prefix string, plan bson.Raw) {
+	if name, ok := plan.Lookup("stage").StringValueOK(); ok {
+		if ms, ok := plan.Lookup("executionTimeMillisEstimate").AsInt64OK(); ok {
+			addStageTiming(timings, prefix+name, ms)
+		}
+	}
+
+	if input, ok := plan.Lookup("inputStage").DocumentOK(); ok {
+		addPlanTimings(timings, prefix, input)
+	}
+	if inputs, ok := plan.Lookup("inputStages").ArrayOK(); ok {
+		vals, _ := inputs.Values()
+		for _, val := range vals {
+			if input, ok := val.DocumentOK(); ok {
+				addPlanTimings(timings, prefix, input)
+			}
+		}
+	}
+}
+
+func addStageTiming(timings map[string]time.Duration, name string, ms int64) {
+	key := name
+	for i := 2; ; i++ {
+		if _, ok := timings[key]; !ok {
+			break
+		}
+		key = fmt.Sprintf("%s#%d", name, i)
+	}
+	timings[key] = time.Duration(ms) * time.Millisecond
+}
@@ -0,0 +1,118 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/internal/testutil/assert"
+)
+
+func TestExplainResultStageTimings(t *testing.T) {
+	marshal := func(t *testing.T, doc interface{}) bson.Raw {
+		t.Helper()
+		raw, err := bson.Marshal(doc)
+		assert.Nil(t, err, "Marshal error: %v", err)
+		return raw
+	}
+	cursorStage := bson.D{
+		{"$cursor", bson.D{
+			{"queryPlanner", bson.D{}},
+			{"executionStats", bson.D{
+				{"executionStages", bson.D{
+					{"stage", "FETCH"},
+					{"executionTimeMillisEstimate", int64(3)},
+					{"inputStage", bson.D{
+						{"stage", "IXSCAN"},
+						{"executionTimeMillisEstimate", int32(1)},
+					}},
+				}},
+			}},
+		}},
+		{"executionTimeMillisEstimate", int64(4)},
+	}
+
+	t.Run("aggregation stages", func(t *testing.T) {
+		er := &ExplainResult{Raw: marshal(t, bson.D{
+			{"stages", bson.A{
+				cursorStage,
+				bson.D{{"$group", bson.D{}}, {"executionTimeMillisEstimate", int64(7)}},
+				bson.D{{"$match", bson.D{}}, {"executionTimeMillisEstimate", int64(8)}},
+				bson.D{{"$match", bson.D{}}, {"executionTimeMillisEstimate", int64(9)}},
+			}},
+		})}
+		timings, err := er.StageTimings()
+		assert.Nil(t, err, "StageTimings error: %v", err)
+
+		expected := map[string]time.Duration{
+			"$cursor":  4 * time.Millisecond,
+			"FETCH":    3 * time.Millisecond,
+			"IXSCAN":   1 * time.Millisecond,
+			"$group":   7 * time.Millisecond,
+			"$match":   8 * time.Millisecond,
+			"$match#2": 9 * time.Millisecond,
+		}
+		assert.Equal(t, expected, timings, "expected timings %v, got %v", expected, timings)
+	})
+	t.Run("sharded", func(t *testing.T) {
+		er := &ExplainResult{Raw: marshal(t, bson.D{
+			{"shards", bson.D{
+				{"shard01", bson.D{{"stages", bson.A{cursorStage}}}},
+				{"shard02", bson.D{
+					{"executionStats", bson.D{
+						{"executionStages", bson.D{
+							{"stage", "SHARDING_FILTER"},
+							{"executionTimeMillisEstimate", 2.0},
+							{"inputStages", bson.A{
+								bson.D{{"stage", "COLLSCAN"}, {"executionTimeMillisEstimate", int32(5)}},
+							}},
+						}},
+					}},
+				}},
+			}},
+		})}
+		timings, err := er.StageTimings()
+		assert.Nil(t, err, "StageTimings error: %v", err)
+
+		expected := map[string]time.Duration{
+			"shard01/$cursor":         4 * time.Millisecond,
+			"shard01/FETCH":           3 * time.Millisecond,
+			"shard01/IXSCAN":          1 * time.Millisecond,
+			"shard02/SHARDING_FILTER": 2 * time.Millisecond,
+			"shard02/COLLSCAN":        5 * time.Millisecond,
+		}
+		assert.Equal(t, expected, timings, "expected timings %v, got %v", expected, timings)
+	})
+	t.Run("queryPlanner verbosity", func(t *testing.T) {
+		er := &ExplainResult{Raw: marshal(t, bson.D{
+			{"stages", bson.A{bson.D{{"$cursor", bson.D{{"queryPlanner", bson.D{}}}}}}},
+		})}
+		_, err := er.StageTimings()
+		assert.NotNil(t, err, "expected StageTimings error, got nil")
+	})
+}
+
+func TestExplainAggregateOutputStage(t *testing.T) {
+	coll := setupColl("explain_aggregate")
+	pipelines := []struct {
+		name     string
+		pipeline interface{}
+	}{
+		{"$out", bson.A{bson.D{{"$match", bson.D{}}}, bson.D{{"$out", "target"}}}},
+		{"$merge", bson.A{bson.D{{"$merge", bson.D{{"into", "target"}}}}}},
+	}
+	for _, tc := range pipelines {
+		for _, verbosity := range []string{"", "executionStats", "allPlansExecution"} {
+			t.Run(tc.name+" "+verbosity, func(t *testing.T) {
+				_, err := coll.ExplainAggregate(bgCtx, tc.pipeline, verbosity)
+				assert.Equal(t, ErrExplainWouldWrite, err, "expected error %v, got %v", ErrExplainWouldWrite, err)
+			})
+		}
+	}
+}
@@ -30,6 +30,13 @@ import (
 // DefaultChunkSize is the default size of each file chunk.
 const DefaultChunkSize int32 = 255 * 1024 // 255 KiB
 
+// MaxChunkSize is the largest supported chunk size in bytes. It leaves room for the other fields of a chunk document
+// so that every chunk fits within the maximum BSON document size of 16 MiB.
+const MaxChunkSize int32 = 16*1024*1024 - chunkDocumentOverhead
+
+// chunkDocumentOverhead is the number of bytes reserved for the fields of a chunk document other than the chunk data.
+const chunkDocumentOverhead = 16 * 1024
+
 // ErrFileNotFound occurs if a user asks to download a file with a file ID that isn't found in the files collection.
 var ErrFileNotFound = errors.New("file with given parameters not found")
 
@@ -75,6 +82,9 @@ func NewBucket(db *mongo.Database, opts ...*options.BucketOptions) (*Bucket, err
 		b.name = *bo.Name
 	}
 	if bo.ChunkSizeBytes != nil {
+		if err := validateChunkSize(*bo.ChunkSizeBytes); err != nil {
+			return nil, err
+		}
 		b.chunkSize = *bo.ChunkSizeBytes
 	}
 	if bo.WriteConcern != nil {
@@ -277,6 +287,46 @@ func (b *Bucket) Find(filter interface{}, opts ...*options.GridFSFindOptions) (*
 		defer cancel()
 	}
 
+	return b.find(ctx, filter, opts...)
+}
+
+// FindFiles returns the files that match the given filter. Unlike Find, the files collection documents are decoded
+// into File instances, so no manual decoding is required.
+//
+// The provided context is used for the entire operation, including iterating over the matching documents. If ctx is
+// nil, the bucket's read deadline is used instead.
+func (b *Bucket) FindFiles(ctx context.Context, filter interface{}, opts ...*options.GridFSFindOptions) ([]*File, error) {
+	if ctx == nil {
+		var cancel context.CancelFunc
+		ctx, cancel = deadlineContext(b.readDeadline)
+		if cancel != nil {
+			defer cancel()
+		}
+	}
+
+	cursor, err := b.find(ctx, filter, opts...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = cursor.Close(ctx)
+	}()
+
+	files := make([]*File, 0)
+	for cursor.Next(ctx) {
+		var file File
+		if err = cursor.Decode(&file); err != nil {
+			return nil, fmt.Errorf("error decoding files collection document: %v", err)
+		}
+		files = append(files, &file)
+	}
+	if err = cursor.Err(); err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+func (b *Bucket) find(ctx context.Context, filter interface{}, opts ...*options.GridFSFindOptions) (*mongo.Cursor, error) {
 	gfsOpts := options.MergeGridFSFindOptions(opts...)
 	find := options.Find()
 	if gfsOpts.AllowDiskUse != nil {
@@ -380,15 +430,27 @@ func (b *Bucket) openDownloadStream(filter interface{}, opts ...*options.FindOpt
 		return nil, fmt.Errorf("error decoding files collection document: %v", err)
 	}
 
+	// Files can be uploaded with a chunk size other than the bucket's, so prefer the size recorded for the file.
+	chunkSize := b.chunkSize
+	if foundFile.ChunkSize > 0 {
+		chunkSize = foundFile.ChunkSize
+	}
 	if foundFile.Length == 0 {
-		return newDownloadStream(nil, b.chunkSize, &foundFile), nil
+		return newDownloadStream(nil, chunkSize, &foundFile), nil
 	}
 
 	chunksCursor, err := b.findChunks(ctx, foundFile.ID)
 	if err != nil {
 		return nil, err
 	}
-	return newDownloadStream(chunksCursor, b.chunkSize, &foundFile), nil
+	return newDownloadStream(chunksCursor, chunkSize, &foundFile), nil
+}
+
+func validateChunkSize(size int32) error {
+	if size <= 0 || size > MaxChunkSize {
+		return fmt.Errorf("chunk size must be between 1 and %d bytes, but was %d", MaxChunkSize, size)
+	}
+	return nil
 }
 
 func deadlineContext(deadline time.Time) (context.Context, context.CancelFunc) {
@@ -597,6 +659,9 @@ func (b *Bucket) parseUploadOptions(opts ...*options.UploadOptions) (*Upload, er
 
 	uo := options.MergeUploadOptions(opts...)
 	if uo.ChunkSizeBytes != nil {
+		if err := validateChunkSize(*uo.ChunkSizeBytes); err != nil {
+			return nil, err
+		}
 		upload.chunkSize = *uo.ChunkSizeBytes
 	}
 	if uo.Registry == nil {
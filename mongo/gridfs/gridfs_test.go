@@ -96,4 +96,17 @@ func TestGridFS(t *testing.T) {
 			})
 		}
 	})
+	t.Run("ChunkSize validation", func(t *testing.T) {
+		invalidSizes := []int32{0, -1, MaxChunkSize + 1}
+
+		for _, size := range invalidSizes {
+			_, err := NewBucket(db, options.GridFSBucket().SetChunkSizeBytes(size))
+			assert.NotNil(t, err, "expected NewBucket error for chunk size %v, got nil", size)
+
+			bucket, err := NewBucket(db)
+			assert.Nil(t, err, "NewBucket error: %v", err)
+			_, err = bucket.OpenUploadStream("filename", options.GridFSUpload().SetChunkSizeBytes(size))
+			assert.NotNil(t, err, "expected OpenUploadStream error for chunk size %v, got nil", size)
+		}
+	})
 }
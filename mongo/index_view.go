@@ -12,6 +12,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
@@ -23,7 +24,6 @@ import (
 	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
 	"go.mongodb.org/mongo-driver/x/mongo/driver"
 	"go.mongodb.org/mongo-driver/x/mongo/driver/operation"
-	"go.mongodb.org/mongo-driver/x/mongo/driver/session"
 )
 
 // ErrInvalidIndexValue is returned if an index is created with a keys document that has a value that is not a number
@@ -74,7 +74,7 @@ func (iv IndexView) List(ctx context.Context, opts ...*options.ListIndexesOption
 	sess := sessionFromContext(ctx)
 	if sess == nil && iv.coll.client.sessionPool != nil {
 		var err error
-		sess, err = session.NewClientSession(iv.coll.client.sessionPool, iv.coll.client.id, session.Implicit)
+		sess, err = iv.coll.db.causalClock.newImplicitSession(iv.coll.client)
 		if err != nil {
 			return nil, err
 		}
@@ -93,18 +93,22 @@ func (iv IndexView) List(ctx context.Context, opts ...*options.ListIndexesOption
 	selector = makeReadPrefSelector(sess, selector, iv.coll.client.localThreshold)
 	op := operation.NewListIndexes().
 		Session(sess).CommandMonitor(iv.coll.client.monitor).
-		ServerSelector(selector).ClusterClock(iv.coll.client.clock).
+		ServerSelector(selector).Timeout(iv.coll.timeout).ClusterClock(iv.coll.client.clock).
 		Database(iv.coll.db.name).Collection(iv.coll.name).
 		Deployment(iv.coll.client.deployment)
 
-	var cursorOpts driver.CursorOptions
+	cursorOpts := driver.CursorOptions{Timeout: iv.coll.timeout}
 	lio := options.MergeListIndexesOptions(opts...)
 	if lio.BatchSize != nil {
 		op = op.BatchSize(*lio.BatchSize)
 		cursorOpts.BatchSize = *lio.BatchSize
 	}
+	if lio.Comment != nil {
+		op = op.Comment(*lio.Comment)
+		cursorOpts.Comment = bsoncore.Value{Type: bsontype.String, Data: bsoncore.AppendString(nil, *lio.Comment)}
+	}
 	if lio.MaxTime != nil {
-		op = op.MaxTimeMS(int64(*lio.MaxTime / time.Millisecond))
+		op = op.MaxTimeMS(int64(*lio.MaxTime / time.Millisecond)).Timeout(lio.MaxTime)
 	}
 	retry := driver.RetryNone
 	if iv.coll.client.retryReads {
@@ -122,6 +126,7 @@ func (iv IndexView) List(ctx context.Context, opts ...*options.ListIndexesOption
 
 		return nil, replaceErrors(err)
 	}
+	iv.coll.db.causalClock.advance(sess)
 
 	bc, err := op.Result(cursorOpts)
 	if err != nil {
@@ -229,11 +234,11 @@ func (iv IndexView) CreateMany(ctx context.Context, models []IndexModel, opts ..
 	sess := sessionFromContext(ctx)
 
 	if sess == nil && iv.coll.client.sessionPool != nil {
-		sess, err = session.NewClientSession(iv.coll.client.sessionPool, iv.coll.client.id, session.Implicit)
+		sess, err = iv.coll.db.causalClock.newImplicitSession(iv.coll.client)
 		if err != nil {
 			return nil, err
 		}
-		defer sess.EndSession()
+		defer iv.coll.db.causalClock.endImplicitSession(sess)
 	}
 
 	err = iv.coll.client.validSession(sess)
@@ -256,10 +261,10 @@ func (iv IndexView) CreateMany(ctx context.Context, models []IndexModel, opts ..
 	op := operation.NewCreateIndexes(indexes).
 		Session(sess).WriteConcern(wc).ClusterClock(iv.coll.client.clock).
 		Database(iv.coll.db.name).Collection(iv.coll.name).CommandMonitor(iv.coll.client.monitor).
-		Deployment(iv.coll.client.deployment).ServerSelector(selector)
+		Deployment(iv.coll.client.deployment).ServerSelector(selector).Timeout(iv.coll.timeout)
 
 	if option.MaxTime != nil {
-		op.MaxTimeMS(int64(*option.MaxTime / time.Millisecond))
+		op.MaxTimeMS(int64(*option.MaxTime / time.Millisecond)).Timeout(option.MaxTime)
 	}
 	if option.CommitQuorum != nil {
 		commitQuorum, err := transformValue(iv.coll.registry, option.CommitQuorum)
@@ -269,6 +274,14 @@ func (iv IndexView) CreateMany(ctx context.Context, models []IndexModel, opts ..
 
 		op.CommitQuorum(commitQuorum)
 	}
+	if option.Comment != nil {
+		comment, err := transformValue(iv.coll.registry, option.Comment)
+		if err != nil {
+			return nil, err
+		}
+
+		op.Comment(comment)
+	}
 
 	err = op.Execute(ctx)
 	if err != nil {
@@ -343,10 +356,16 @@ func (iv IndexView) createOptionsDoc(opts *options.IndexOptions) (bsoncore.Docum
 		if err != nil {
 			return nil, err
 		}
+		if err = validatePartialFilterExpression(doc); err != nil {
+			return nil, err
+		}
 
 		optsDoc = bsoncore.AppendDocumentElement(optsDoc, "partialFilterExpression", doc)
 	}
 	if opts.Collation != nil {
+		if err := validateIndexCollation(opts.Collation); err != nil {
+			return nil, err
+		}
 		optsDoc = bsoncore.AppendDocumentElement(optsDoc, "collation", bsoncore.Document(opts.Collation.ToDocument()))
 	}
 	if opts.WildcardProjection != nil {
@@ -372,11 +391,11 @@ func (iv IndexView) drop(ctx context.Context, name string, opts ...*options.Drop
 	sess := sessionFromContext(ctx)
 	if sess == nil && iv.coll.client.sessionPool != nil {
 		var err error
-		sess, err = session.NewClientSession(iv.coll.client.sessionPool, iv.coll.client.id, session.Implicit)
+		sess, err = iv.coll.db.causalClock.newImplicitSession(iv.coll.client)
 		if err != nil {
 			return nil, err
 		}
-		defer sess.EndSession()
+		defer iv.coll.db.causalClock.endImplicitSession(sess)
 	}
 
 	err := iv.coll.client.validSession(sess)
@@ -397,11 +416,19 @@ func (iv IndexView) drop(ctx context.Context, name string, opts ...*options.Drop
 	dio := options.MergeDropIndexesOptions(opts...)
 	op := operation.NewDropIndexes(name).
 		Session(sess).WriteConcern(wc).CommandMonitor(iv.coll.client.monitor).
-		ServerSelector(selector).ClusterClock(iv.coll.client.clock).
+		ServerSelector(selector).Timeout(iv.coll.timeout).ClusterClock(iv.coll.client.clock).
 		Database(iv.coll.db.name).Collection(iv.coll.name).
 		Deployment(iv.coll.client.deployment)
 	if dio.MaxTime != nil {
-		op.MaxTimeMS(int64(*dio.MaxTime / time.Millisecond))
+		op.MaxTimeMS(int64(*dio.MaxTime / time.Millisecond)).Timeout(dio.MaxTime)
+	}
+	if dio.Comment != nil {
+		comment, err := transformValue(iv.coll.registry, dio.Comment)
+		if err != nil {
+			return nil, err
+		}
+
+		op.Comment(comment)
 	}
 
 	err = op.Execute(ctx)
@@ -447,6 +474,101 @@ func (iv IndexView) DropAll(ctx context.Context, opts ...*options.DropIndexesOpt
 	return iv.drop(ctx, "*", opts...)
 }
 
+// partialFilterOperators contains the query operators that can be used on a field in a partial filter expression by
+// some server version. $in is only supported by MongoDB 6.0 and later.
+var partialFilterOperators = map[string]struct{}{
+	"$eq":     {},
+	"$exists": {},
+	"$gt":     {},
+	"$gte":    {},
+	"$in":     {},
+	"$lt":     {},
+	"$lte":    {},
+	"$type":   {},
+}
+
+// validatePartialFilterExpression returns an error naming the first operator in filter that no server version supports
+// in a partial filter expression. The supported expressions are equality matches, $exists: true, $gt, $gte, $lt, $lte,
+// $type, $in, $and, and $or. $and and $or are checked the same way at any depth. Restrictions that depend on the server
+// version, such as $in and $or requiring MongoDB 6.0 or later, are left to the server.
+func validatePartialFilterExpression(filter bsoncore.Document) error {
+	elems, err := filter.Elements()
+	if err != nil {
+		return err
+	}
+	for _, elem := range elems {
+		key := elem.Key()
+		if key == "$and" || key == "$or" {
+			arr, ok := elem.Value().ArrayOK()
+			if !ok {
+				return fmt.Errorf("partial filter expression %s value must be an array", key)
+			}
+			vals, err := arr.Values()
+			if err != nil {
+				return err
+			}
+			for _, val := range vals {
+				doc, ok := val.DocumentOK()
+				if !ok {
+					return fmt.Errorf("partial filter expression %s elements must be documents", key)
+				}
+				if err = validatePartialFilterExpression(doc); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		if strings.HasPrefix(key, "$") {
+			return fmt.Errorf("operator %s is not supported in a partial filter expression", key)
+		}
+		if err = validatePartialFilterField(key, elem.Value()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validatePartialFilterField validates the condition for a single field in a partial filter expression. Values other
+// than documents of operators are equality matches, which are always supported.
+func validatePartialFilterField(field string, val bsoncore.Value) error {
+	doc, ok := val.DocumentOK()
+	if !ok {
+		return nil
+	}
+	elems, err := doc.Elements()
+	if err != nil {
+		return err
+	}
+	if len(elems) == 0 || !strings.HasPrefix(elems[0].Key(), "$") {
+		return nil
+	}
+
+	for _, elem := range elems {
+		op := elem.Key()
+		if _, ok := partialFilterOperators[op]; !ok {
+			return fmt.Errorf("operator %s on field %q is not supported in a partial filter expression", op, field)
+		}
+		if op == "$exists" {
+			if exists, ok := elem.Value().BooleanOK(); !ok || !exists {
+				return fmt.Errorf("operator $exists on field %q must be true in a partial filter expression", field)
+			}
+		}
+	}
+	return nil
+}
+
+// validateIndexCollation returns an error if collation does not specify a locale or has a strength outside the range
+// supported by the server. A zero Strength is omitted from the command and uses the server default.
+func validateIndexCollation(collation *options.Collation) error {
+	if collation.Locale == "" {
+		return errors.New("index collation must specify a locale")
+	}
+	if collation.Strength != 0 && (collation.Strength < 1 || collation.Strength > 5) {
+		return fmt.Errorf("index collation strength must be between 1 and 5, got %d", collation.Strength)
+	}
+	return nil
+}
+
 func getOrGenerateIndexName(keySpecDocument bsoncore.Document, model IndexModel) (string, error) {
 	if model.Options != nil && model.Options.Name != nil {
 		return *model.Options.Name, nil
@@ -0,0 +1,89 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/internal/testutil/assert"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
+)
+
+func TestValidatePartialFilterExpression(t *testing.T) {
+	testCases := []struct {
+		name   string
+		filter bson.D
+		errStr string
+	}{
+		{"equality", bson.D{{"status", "active"}, {"sub", bson.D{{"x", 1}}}}, ""},
+		{"supported operators", bson.D{
+			{"qty", bson.D{{"$gte", 10}, {"$lt", 100}}},
+			{"email", bson.D{{"$exists", true}, {"$type", "string"}}},
+		}, ""},
+		{"and", bson.D{{"$and", bson.A{
+			bson.D{{"qty", bson.D{{"$gt", 1}}}},
+			bson.D{{"status", bson.D{{"$eq", "active"}}}},
+		}}}, ""},
+		{"or and in", bson.D{{"$or", bson.A{
+			bson.D{{"x", bson.D{{"$in", bson.A{1, 2}}}}},
+			bson.D{{"$and", bson.A{bson.D{{"y", 1}}}}},
+		}}}, ""},
+		{"unsupported field operator", bson.D{{"qty", bson.D{{"$ne", 5}}}},
+			`operator $ne on field "qty" is not supported in a partial filter expression`},
+		{"unsupported top-level operator", bson.D{{"$nor", bson.A{bson.D{{"x", 1}}}}},
+			"operator $nor is not supported in a partial filter expression"},
+		{"unsupported operator in or", bson.D{{"$or", bson.A{bson.D{{"x", bson.D{{"$nin", bson.A{1, 2}}}}}}}},
+			`operator $nin on field "x" is not supported in a partial filter expression`},
+		{"nested and", bson.D{{"$and", bson.A{bson.D{{"$and", bson.A{bson.D{{"x", bson.D{{"$regex", "a"}}}}}}}}}},
+			`operator $regex on field "x" is not supported in a partial filter expression`},
+		{"exists false", bson.D{{"email", bson.D{{"$exists", false}}}},
+			`operator $exists on field "email" must be true in a partial filter expression`},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			doc, err := bson.Marshal(tc.filter)
+			assert.Nil(t, err, "Marshal error: %v", err)
+
+			err = validatePartialFilterExpression(bsoncore.Document(doc))
+			if tc.errStr == "" {
+				assert.Nil(t, err, "validatePartialFilterExpression error: %v", err)
+				return
+			}
+			assert.NotNil(t, err, "expected error %q, got nil", tc.errStr)
+			assert.Equal(t, tc.errStr, err.Error(), "expected error %q, got %q", tc.errStr, err.Error())
+		})
+	}
+}
+
+func TestValidateIndexCollation(t *testing.T) {
+	testCases := []struct {
+		name      string
+		collation options.Collation
+		errStr    string
+	}{
+		{"locale only", options.Collation{Locale: "en"}, ""},
+		{"valid strength", options.Collation{Locale: "en", Strength: 2}, ""},
+		{"missing locale", options.Collation{Strength: 2}, "index collation must specify a locale"},
+		{"strength too high", options.Collation{Locale: "en", Strength: 6},
+			"index collation strength must be between 1 and 5, got 6"},
+		{"negative strength", options.Collation{Locale: "en", Strength: -1},
+			"index collation strength must be between 1 and 5, got -1"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateIndexCollation(&tc.collation)
+			if tc.errStr == "" {
+				assert.Nil(t, err, "validateIndexCollation error: %v", err)
+				return
+			}
+			assert.NotNil(t, err, "expected error %q, got nil", tc.errStr)
+			assert.Equal(t, tc.errStr, err.Error(), "expected error %q, got %q", tc.errStr, err.Error())
+		})
+	}
+}
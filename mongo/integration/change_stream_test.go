@@ -338,6 +338,34 @@ func TestChangeStream_ReplicaSet(t *testing.T) {
 		_, err = e.Command.LookupErr("maxTimeMS")
 		assert.Nil(mt, err, "field maxTimeMS not found in command %v", e.Command)
 	})
+	mt.RunOpts("comment", mtest.NewOptions().MinServerVersion("4.4"), func(mt *mtest.T) {
+		// comment option should be sent on the aggregate and all subsequent getMore commands
+
+		comment := bson.D{{"trace", "cdc"}}
+		mt.ClearEvents()
+		cs, err := mt.Coll.Watch(mtest.Background, mongo.Pipeline{}, options.ChangeStream().SetComment(comment))
+		assert.Nil(mt, err, "Watch error: %v", err)
+		defer closeStream(cs)
+
+		expected, err := bson.Marshal(comment)
+		assert.Nil(mt, err, "Marshal error: %v", err)
+		e := mt.GetStartedEvent()
+		assert.NotNil(mt, e, "expected aggregate event, got nil")
+		actual, ok := e.Command.Lookup("comment").DocumentOK()
+		assert.True(mt, ok, "expected comment document in command %v", e.Command)
+		assert.Equal(mt, bson.Raw(expected), actual, "expected comment %v, got %v", bson.Raw(expected), actual)
+
+		_, err = mt.Coll.InsertOne(mtest.Background, bson.D{{"x", 1}})
+		assert.Nil(mt, err, "InsertOne error: %v", err)
+		mt.ClearEvents()
+		assert.True(mt, cs.Next(mtest.Background), "expected Next true, got false")
+
+		e = mt.GetStartedEvent()
+		assert.NotNil(mt, e, "expected getMore event, got nil")
+		actual, ok = e.Command.Lookup("comment").DocumentOK()
+		assert.True(mt, ok, "expected comment document in command %v", e.Command)
+		assert.Equal(mt, bson.Raw(expected), actual, "expected comment %v, got %v", bson.Raw(expected), actual)
+	})
 	mt.RunOpts("resume token", noClientOpts, func(mt *mtest.T) {
 		// Prose tests to make assertions on resume tokens for change streams that have not done a getMore yet
 		mt.RunOpts("no getMore", noClientOpts, func(mt *mtest.T) {
@@ -608,6 +636,38 @@ func TestChangeStream_ReplicaSet(t *testing.T) {
 		// next call to cs.Next should return False since cursor is closed
 		assert.False(mt, cs.Next(mtest.Background), "expected to return false, but returned true")
 	})
+	mt.RunOpts("reopen on invalidate", mtest.NewOptions().MinServerVersion("4.2"), func(mt *mtest.T) {
+		// The stream should return the invalidate event and then continue returning events once the collection is
+		// recreated.
+
+		opts := options.ChangeStream().SetReopenOnInvalidate(true)
+		cs, err := mt.Coll.Watch(mtest.Background, mongo.Pipeline{}, opts)
+		assert.Nil(mt, err, "Watch error: %v", err)
+		defer closeStream(cs)
+
+		generateEvents(mt, 1)
+		err = mt.Coll.Drop(mtest.Background)
+		assert.Nil(mt, err, "Drop error: %v", err)
+
+		// insert, drop, and invalidate events
+		for i := 0; i < 3; i++ {
+			assert.True(mt, cs.Next(mtest.Background), "Next returned false at index %d; iteration error: %v", i, cs.Err())
+		}
+		operationType := cs.Current.Lookup("operationType").StringValue()
+		assert.Equal(mt, "invalidate", operationType, "expected invalidate event but returned %q event", operationType)
+
+		generateEvents(mt, 1)
+		mt.ClearEvents()
+		assert.True(mt, cs.Next(mtest.Background), "expected Next true, got false; iteration error: %v", cs.Err())
+		operationType = cs.Current.Lookup("operationType").StringValue()
+		assert.Equal(mt, "insert", operationType, "expected insert event but returned %q event", operationType)
+
+		evt := mt.GetStartedEvent()
+		assert.Equal(mt, "aggregate", evt.CommandName, "expected command %q, got %q", "aggregate", evt.CommandName)
+		csStage := evt.Command.Lookup("pipeline", "0", "$changeStream").Document()
+		_, err = csStage.LookupErr("startAfter")
+		assert.Nil(mt, err, "expected startAfter in $changeStream stage %s", csStage)
+	})
 }
 
 func closeStream(cs *mongo.ChangeStream) {
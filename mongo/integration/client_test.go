@@ -11,6 +11,7 @@ import (
 	"path"
 	"reflect"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -19,6 +20,7 @@ import (
 	"go.mongodb.org/mongo-driver/bson/bsonrw"
 	"go.mongodb.org/mongo-driver/internal/testutil/assert"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/address"
 	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.mongodb.org/mongo-driver/mongo/readpref"
@@ -225,6 +227,22 @@ func TestClient(t *testing.T) {
 				})
 			}
 		})
+		mt.Run("nameOnly is always sent", func(mt *mtest.T) {
+			mt.ClearEvents()
+
+			_, err := mt.Client.ListDatabaseNames(mtest.Background, bson.D{}, options.ListDatabases().SetNameOnly(false))
+			assert.Nil(mt, err, "ListDatabaseNames error: %v", err)
+
+			evt := mt.GetStartedEvent()
+			assert.Equal(mt, "listDatabases", evt.CommandName, "expected command %q, got %q", "listDatabases",
+				evt.CommandName)
+
+			expectedDoc := bsoncore.BuildDocumentFromElements(nil,
+				bsoncore.AppendBooleanElement(nil, "nameOnly", true),
+			)
+			err = compareDocs(mt, expectedDoc, evt.Command)
+			assert.Nil(mt, err, "compareDocs error: %v", err)
+		})
 		mt.Run("options", func(mt *mtest.T) {
 			allOpts := options.ListDatabases().SetNameOnly(true).SetAuthorizedDatabases(true)
 			mt.ClearEvents()
@@ -290,6 +308,23 @@ func TestClient(t *testing.T) {
 		_, err := mt.Coll.InsertOne(mtest.Background, bson.D{{"x", 1}})
 		assert.Nil(mt, err, "InsertOne error: %v", err)
 	})
+	var observedMu sync.Mutex
+	var observed []address.Address
+	observerClientOpts := options.Client().SetClusterTimeObserver(func(addr address.Address, clusterTime bson.Raw) {
+		observedMu.Lock()
+		defer observedMu.Unlock()
+		observed = append(observed, addr)
+	})
+	observerOpts := mtest.NewOptions().ClientOptions(observerClientOpts).MinServerVersion("3.6").
+		Topologies(mtest.ReplicaSet, mtest.Sharded)
+	mt.RunOpts("cluster time observer", observerOpts, func(mt *mtest.T) {
+		_, err := mt.Coll.InsertOne(mtest.Background, bson.D{{"x", 1}})
+		assert.Nil(mt, err, "InsertOne error: %v", err)
+
+		observedMu.Lock()
+		defer observedMu.Unlock()
+		assert.True(mt, len(observed) > 0, "expected cluster time observer to be called")
+	})
 	sessionOpts := mtest.NewOptions().MinServerVersion("3.6.0").CreateClient(false)
 	mt.RunOpts("causal consistency", sessionOpts, func(mt *mtest.T) {
 		testCases := []struct {
@@ -19,9 +19,12 @@ import (
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
 	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readconcern"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
 	"go.mongodb.org/mongo-driver/mongo/writeconcern"
 	"go.mongodb.org/mongo-driver/x/bsonx"
 	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
+	"go.mongodb.org/mongo-driver/x/mongo/driver"
 )
 
 const (
@@ -51,6 +54,25 @@ func TestCollection(t *testing.T) {
 			assert.Nil(mt, err, "InsertOne error: %v", err)
 			assert.Equal(mt, id, res.InsertedID, "expected inserted ID %v, got %v", id, res.InsertedID)
 		})
+		mt.RunOpts("bypass empty timestamp replacement", mtest.NewOptions().MinServerVersion("8.0"), func(mt *mtest.T) {
+			doc := bson.D{{"_id", 1}, {"ts", primitive.Timestamp{}}}
+			opts := options.InsertOne().SetBypassEmptyTimestampReplacement(true)
+			mt.ClearEvents()
+			_, err := mt.Coll.InsertOne(mtest.Background, doc, opts)
+			assert.Nil(mt, err, "InsertOne error: %v", err)
+
+			evt := mt.GetStartedEvent()
+			val, err := evt.Command.LookupErr("bypassEmptyTsReplacement")
+			assert.Nil(mt, err, "bypassEmptyTsReplacement not found in command %v", evt.Command)
+			assert.True(mt, val.Boolean(), "expected bypassEmptyTsReplacement true, got %v", val)
+
+			var res struct {
+				Ts primitive.Timestamp `bson:"ts"`
+			}
+			err = mt.Coll.FindOne(mtest.Background, bson.D{{"_id", 1}}).Decode(&res)
+			assert.Nil(mt, err, "FindOne error: %v", err)
+			assert.True(mt, res.Ts.IsZero(), "expected empty timestamp to be preserved, got %v", res.Ts)
+		})
 		mt.Run("write error", func(mt *mtest.T) {
 			doc := bson.D{{"_id", 1}}
 			_, err := mt.Coll.InsertOne(mtest.Background, doc)
@@ -91,6 +113,20 @@ func TestCollection(t *testing.T) {
 			assert.NotNil(mt, res.InsertedIDs[1], "expected ID but got nil")
 			assert.Equal(mt, want2, res.InsertedIDs[2], "expected inserted ID %v, got %v", want2, res.InsertedIDs[2])
 		})
+		mt.Run("document too large", func(mt *mtest.T) {
+			docs := []interface{}{
+				bson.D{{"x", 1}},
+				bson.D{{"x", strings.Repeat("a", 17*1024*1024)}},
+			}
+
+			mt.ClearEvents()
+			_, err := mt.Coll.InsertMany(mtest.Background, docs)
+			dtle, ok := err.(driver.DocumentTooLargeError)
+			assert.True(mt, ok, "expected error type %T, got %T", driver.DocumentTooLargeError{}, err)
+			assert.Equal(mt, 1, dtle.Index, "expected index 1, got %v", dtle.Index)
+			evt := mt.GetStartedEvent()
+			assert.Nil(mt, evt, "expected no command to be sent, got %v", evt)
+		})
 		mt.Run("batches", func(mt *mtest.T) {
 			// TODO(GODRIVER-425): remove this as part a larger project to
 			// refactor integration and other longrunning tasks.
@@ -166,6 +202,22 @@ func TestCollection(t *testing.T) {
 				})
 			}
 		})
+		mt.RunOpts("unordered write errors", noClientOpts, func(mt *mtest.T) {
+			docs := []interface{}{
+				bson.D{{"_id", 1}},
+				bson.D{{"_id", 1}},
+				bson.D{{"_id", 2}},
+				bson.D{{"_id", 2}},
+			}
+			res, err := mt.Coll.InsertMany(mtest.Background, docs, options.InsertMany().SetUnordered())
+
+			we, ok := err.(mongo.BulkWriteException)
+			assert.True(mt, ok, "expected error type %T, got %T", mongo.BulkWriteException{}, err)
+			assert.Equal(mt, 2, len(we.WriteErrors), "expected 2 write errors, got %v", len(we.WriteErrors))
+			assert.Equal(mt, 1, we.WriteErrors[0].Index, "expected index 1, got %v", we.WriteErrors[0].Index)
+			assert.Equal(mt, 3, we.WriteErrors[1].Index, "expected index 3, got %v", we.WriteErrors[1].Index)
+			assert.Equal(mt, 2, len(res.InsertedIDs), "expected 2 inserted IDs, got %v", len(res.InsertedIDs))
+		})
 		mt.Run("return only inserted ids", func(mt *mtest.T) {
 			id := int32(11)
 			docs := []interface{}{
@@ -305,6 +357,27 @@ func TestCollection(t *testing.T) {
 			assert.Nil(mt, err, "DeleteMany error: %v", err)
 			assert.Equal(mt, int64(0), res.DeletedCount, "expected DeletedCount 0, got %v", res.DeletedCount)
 		})
+		mt.Run("batched", func(mt *mtest.T) {
+			initCollection(mt, mt.Coll)
+			mt.ClearEvents()
+			deleted, err := mt.Coll.DeleteManyBatched(mtest.Background, bson.D{{"x", bson.D{{"$gte", 2}}}}, 3, time.Millisecond)
+			assert.Nil(mt, err, "DeleteManyBatched error: %v", err)
+			assert.Equal(mt, int64(4), deleted, "expected 4 documents deleted, got %v", deleted)
+
+			var deletes int
+			for evt := mt.GetStartedEvent(); evt != nil; evt = mt.GetStartedEvent() {
+				if evt.CommandName == "delete" {
+					deletes++
+					gte, ok := evt.Command.Lookup("deletes", "0", "q", "$and", "0", "x", "$gte").Int32OK()
+					assert.True(mt, ok && gte == 2, "expected delete filter to include the original filter, got %v",
+						evt.Command)
+				}
+			}
+			assert.Equal(mt, 2, deletes, "expected 2 delete commands, got %v", deletes)
+			count, err := mt.Coll.CountDocuments(mtest.Background, bson.D{})
+			assert.Nil(mt, err, "CountDocuments error: %v", err)
+			assert.Equal(mt, int64(1), count, "expected 1 document remaining, got %v", count)
+		})
 		mt.RunOpts("not found with options", mtest.NewOptions().MinServerVersion("3.4"), func(mt *mtest.T) {
 			initCollection(mt, mt.Coll)
 			opts := options.Delete().SetCollation(&options.Collation{Locale: "en_US"})
@@ -569,6 +642,29 @@ func TestCollection(t *testing.T) {
 		})
 	})
 	mt.RunOpts("aggregate", noClientOpts, func(mt *mtest.T) {
+		mergeOpts := mtest.NewOptions().MinServerVersion("4.2").Topologies(mtest.Sharded).
+			CollectionOptions(options.Collection().SetReadPreference(readpref.Secondary()))
+		mt.RunOpts("merge stage ignores read preference on sharded clusters", mergeOpts, func(mt *mtest.T) {
+			initCollection(mt, mt.Coll)
+			pipeline := bsonx.Arr{
+				bsonx.Document(bsonx.Doc{{"$group", bsonx.Document(bsonx.Doc{
+					{"_id", bsonx.Null()},
+					{"total", bsonx.Document(bsonx.Doc{{"$sum", bsonx.String("$x")}})},
+				})}}),
+				bsonx.Document(bsonx.Doc{{"$merge", bsonx.Document(bsonx.Doc{
+					{"into", bsonx.String(mt.Coll.Name() + "_merged")},
+				})}}),
+			}
+			mt.ClearEvents()
+			cursor, err := mt.Coll.Aggregate(mtest.Background, pipeline)
+			assert.Nil(mt, err, "Aggregate error: %v", err)
+			_ = cursor.Close(mtest.Background)
+
+			evt := mt.GetStartedEvent()
+			_, err = evt.Command.LookupErr("$readPreference")
+			assert.NotNil(mt, err, "expected $readPreference to be omitted for $merge, got %v", evt.Command)
+			_ = mt.DB.Collection(mt.Coll.Name() + "_merged").Drop(mtest.Background)
+		})
 		mt.Run("success", func(mt *mtest.T) {
 			initCollection(mt, mt.Coll)
 			pipeline := bson.A{
@@ -593,6 +689,24 @@ func TestCollection(t *testing.T) {
 				assert.Equal(mt, int32(i), num.Int32(), "expected x value %v, got %v", i, num.Int32())
 			}
 		})
+		mt.Run("schema", func(mt *mtest.T) {
+			docs := []interface{}{
+				bson.D{{"x", 1}, {"sub", bson.D{{"y", "foo"}}}},
+				bson.D{{"x", "one"}},
+				bson.D{{"x", 1.5}},
+			}
+			_, err := mt.Coll.InsertMany(mtest.Background, docs)
+			assert.Nil(mt, err, "InsertMany error: %v", err)
+			pipeline := mongo.Pipeline{
+				{{"$sort", bson.D{{"_id", 1}}}},
+				{{"$project", bson.D{{"_id", 0}}}},
+			}
+
+			schema, err := mt.Coll.AggregateSchema(mtest.Background, pipeline, 2)
+			assert.Nil(mt, err, "AggregateSchema error: %v", err)
+			want := map[string]string{"x": "int|string", "sub": "object", "sub.y": "string"}
+			assert.Equal(mt, want, schema, "expected schema %v, got %v", want, schema)
+		})
 		mt.RunOpts("index hint", mtest.NewOptions().MinServerVersion("3.6"), func(mt *mtest.T) {
 			hint := bson.D{{"x", 1}}
 			testAggregateWithOptions(mt, true, options.Aggregate().SetHint(hint))
@@ -609,6 +723,69 @@ func TestCollection(t *testing.T) {
 			_, ok := err.(mongo.WriteConcernError)
 			assert.True(mt, ok, "expected error type %v, got %v", mongo.WriteConcernError{}, err)
 		})
+		mt.RunOpts("let", mtest.NewOptions().MinServerVersion("5.0"), func(mt *mtest.T) {
+			initCollection(mt, mt.Coll)
+			pipeline := mongo.Pipeline{{{"$match", bson.D{{"$expr", bson.D{{"$eq", bson.A{"$x", "$$target"}}}}}}}}
+			aggOpts := options.Aggregate().SetLet(bson.D{{"target", 3}}).SetValidateLetVariables(true)
+			cursor, err := mt.Coll.Aggregate(mtest.Background, pipeline, aggOpts)
+			assert.Nil(mt, err, "Aggregate error: %v", err)
+			var docs []bson.Raw
+			err = cursor.All(mtest.Background, &docs)
+			assert.Nil(mt, err, "All error: %v", err)
+			assert.Equal(mt, 1, len(docs), "expected 1 document, got %v", len(docs))
+		})
+		mt.Run("undeclared let variable", func(mt *mtest.T) {
+			pipeline := mongo.Pipeline{{{"$project", bson.D{{"y", "$$missing"}}}}}
+			aggOpts := options.Aggregate().SetValidateLetVariables(true)
+			mt.ClearEvents()
+			_, err := mt.Coll.Aggregate(mtest.Background, pipeline, aggOpts)
+			assert.NotNil(mt, err, "expected Aggregate error, got nil")
+			evt := mt.GetStartedEvent()
+			assert.Nil(mt, evt, "expected no command to be sent, got %v", evt)
+		})
+		mt.RunOpts("explain stage timings", mtest.NewOptions().MinServerVersion("4.4"), func(mt *mtest.T) {
+			initCollection(mt, mt.Coll)
+			pipeline := mongo.Pipeline{
+				{{"$match", bson.D{{"x", bson.D{{"$gte", 2}}}}}},
+				{{"$group", bson.D{{"_id", nil}, {"total", bson.D{{"$sum", "$x"}}}}}},
+			}
+			res, err := mt.Coll.ExplainAggregate(mtest.Background, pipeline, "executionStats")
+			assert.Nil(mt, err, "ExplainAggregate error: %v", err)
+			timings, err := res.StageTimings()
+			assert.Nil(mt, err, "StageTimings error: %v", err)
+			assert.NotEqual(mt, 0, len(timings), "expected stage timings, got none")
+
+			res, err = mt.Coll.ExplainAggregate(mtest.Background, pipeline, "queryPlanner")
+			assert.Nil(mt, err, "ExplainAggregate error: %v", err)
+			_, err = res.StageTimings()
+			assert.NotNil(mt, err, "expected StageTimings error, got nil")
+		})
+	})
+	mt.RunOpts("selected server wire version", noClientOpts, func(mt *mtest.T) {
+		mt.ClearEvents()
+		min, max, err := mt.Coll.SelectedServerWireVersion(mtest.Background, readpref.Primary())
+		assert.Nil(mt, err, "SelectedServerWireVersion error: %v", err)
+		assert.True(mt, max > 0, "expected max wire version to be positive, got %v", max)
+		assert.True(mt, min <= max, "expected min wire version %v to be at most max wire version %v", min, max)
+
+		evt := mt.GetStartedEvent()
+		assert.Nil(mt, evt, "expected no command to be run, got %v", evt)
+	})
+	mt.RunOpts("batch inserter", noClientOpts, func(mt *mtest.T) {
+		bi := mt.Coll.NewBatchInserter(mtest.Background, mongo.BatchInserterOptions{BatchSize: 2})
+		for i := 0; i < 5; i++ {
+			err := bi.Add(bson.D{{"x", i}})
+			assert.Nil(mt, err, "Add error: %v", err)
+		}
+		count, err := mt.Coll.CountDocuments(mtest.Background, bson.D{})
+		assert.Nil(mt, err, "CountDocuments error: %v", err)
+		assert.Equal(mt, int64(4), count, "expected %v documents before Close, got %v", 4, count)
+
+		err = bi.Close()
+		assert.Nil(mt, err, "Close error: %v", err)
+		count, err = mt.Coll.CountDocuments(mtest.Background, bson.D{})
+		assert.Nil(mt, err, "CountDocuments error: %v", err)
+		assert.Equal(mt, int64(5), count, "expected %v documents after Close, got %v", 5, count)
 	})
 	mt.RunOpts("count documents", noClientOpts, func(mt *mtest.T) {
 		testCases := []struct {
@@ -630,6 +807,21 @@ func TestCollection(t *testing.T) {
 				assert.Equal(mt, tc.count, count, "expected count %v, got %v", tc.count, count)
 			})
 		}
+		mt.RunOpts("hint", mtest.NewOptions().MinServerVersion("3.6"), func(mt *mtest.T) {
+			filters := []bson.D{{}, {{"x", bson.D{{"$gt", 2}}}}}
+			for _, filter := range filters {
+				initCollection(mt, mt.Coll)
+				mt.ClearEvents()
+				_, err := mt.Coll.CountDocuments(mtest.Background, filter, options.Count().SetHint("_id_"))
+				assert.Nil(mt, err, "CountDocuments error: %v", err)
+
+				evt := mt.GetStartedEvent()
+				assert.Equal(mt, "aggregate", evt.CommandName, "expected command 'aggregate', got '%v'", evt.CommandName)
+				hint, err := evt.Command.LookupErr("hint")
+				assert.Nil(mt, err, "expected hint to be sent for filter %v", filter)
+				assert.Equal(mt, "_id_", hint.StringValue(), "expected hint '_id_', got %v", hint)
+			}
+		})
 	})
 	mt.RunOpts("estimated document count", noClientOpts, func(mt *mtest.T) {
 		testCases := []struct {
@@ -670,6 +862,26 @@ func TestCollection(t *testing.T) {
 				assert.Equal(mt, tc.expected, res, "expected result %v, got %v", tc.expected, res)
 			})
 		}
+		rcCollOpts := options.Collection().SetReadConcern(readconcern.Majority())
+		hintOpts := mtest.NewOptions().MinServerVersion("7.1").CollectionOptions(rcCollOpts)
+		mt.RunOpts("hint and read concern", hintOpts, func(mt *mtest.T) {
+			initCollection(mt, mt.Coll)
+			_, err := mt.Coll.Indexes().CreateOne(mtest.Background, mongo.IndexModel{Keys: bson.D{{"x", 1}}})
+			assert.Nil(mt, err, "CreateOne error: %v", err)
+
+			mt.ClearEvents()
+			_, err = mt.Coll.Distinct(mtest.Background, "x", bson.D{}, options.Distinct().SetHint("x_1"))
+			assert.Nil(mt, err, "Distinct error: %v", err)
+
+			evt := mt.GetStartedEvent()
+			assert.Equal(mt, "distinct", evt.CommandName, "expected command 'distinct', got %q", evt.CommandName)
+			hint, err := evt.Command.LookupErr("hint")
+			assert.Nil(mt, err, "hint not found in command %v", evt.Command)
+			assert.Equal(mt, "x_1", hint.StringValue(), "expected hint 'x_1', got %v", hint)
+			level, err := evt.Command.LookupErr("readConcern", "level")
+			assert.Nil(mt, err, "readConcern level not found in command %v", evt.Command)
+			assert.Equal(mt, "majority", level.StringValue(), "expected read concern 'majority', got %v", level)
+		})
 	})
 	mt.RunOpts("find", noClientOpts, func(mt *mtest.T) {
 		mt.Run("found", func(mt *mtest.T) {
@@ -703,6 +915,21 @@ func TestCollection(t *testing.T) {
 				assert.Equal(mt, 3, numReceived, "expected 3 results, got %v", numReceived)
 			}
 		})
+		rcOpts := mtest.NewOptions().MinServerVersion("3.2").
+			CollectionOptions(options.Collection().SetReadConcern(readconcern.Majority()))
+		mt.RunOpts("read concern override", rcOpts, func(mt *mtest.T) {
+			initCollection(mt, mt.Coll)
+			mt.ClearEvents()
+			cursor, err := mt.Coll.Find(mtest.Background, bson.D{}, options.Find().SetReadConcern(readconcern.Local()))
+			assert.Nil(mt, err, "Find error: %v", err)
+			_ = cursor.Close(mtest.Background)
+
+			evt := mt.GetStartedEvent()
+			assert.Equal(mt, "find", evt.CommandName, "expected command 'find', got %q", evt.CommandName)
+			level, err := evt.Command.LookupErr("readConcern", "level")
+			assert.Nil(mt, err, "readConcern level not found in command %v", evt.Command)
+			assert.Equal(mt, "local", level.StringValue(), "expected read concern 'local', got %v", level)
+		})
 		mt.Run("not found", func(mt *mtest.T) {
 			initCollection(mt, mt.Coll)
 			cursor, err := mt.Coll.Find(mtest.Background, bson.D{{"x", 6}})
@@ -891,6 +1118,19 @@ func TestCollection(t *testing.T) {
 			err := mt.Coll.FindOneAndUpdate(mtest.Background, filter, update).Err()
 			assert.Nil(mt, err, "FindOneAndUpdate error: %v", err)
 		})
+		mt.Run("present fields with projection", func(mt *mtest.T) {
+			_, err := mt.Coll.InsertOne(mtest.Background, bson.D{{"_id", 1}, {"x", 0}, {"y", ""}})
+			assert.Nil(mt, err, "InsertOne error: %v", err)
+			update := bson.D{{"$set", bson.D{{"z", 1}}}}
+			opts := options.FindOneAndUpdate().
+				SetProjection(bson.D{{"x", 1}, {"z", 1}}).
+				SetReturnDocument(options.After)
+
+			fields, err := mt.Coll.FindOneAndUpdate(mtest.Background, bson.D{{"_id", 1}}, update, opts).PresentFields()
+			assert.Nil(mt, err, "PresentFields error: %v", err)
+			want := []string{"_id", "x", "z"}
+			assert.Equal(mt, want, fields, "expected fields %v, got %v", want, fields)
+		})
 		mt.Run("not found", func(mt *mtest.T) {
 			initCollection(mt, mt.Coll)
 			filter := bson.D{{"x", 6}}
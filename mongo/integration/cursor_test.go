@@ -165,6 +165,22 @@ func TestCursor(t *testing.T) {
 			assertCursorBatchLength(mt, cursor, len(getMoreBatch)-1)
 		})
 	})
+	mt.RunOpts("read ahead", mtest.NewOptions().MinServerVersion("3.2"), func(mt *mtest.T) {
+		initCollection(mt, mt.Coll)
+		opts := options.Find().SetBatchSize(2).SetSort(bson.D{{"x", 1}}).SetReadAhead(true)
+		cursor, err := mt.Coll.Find(mtest.Background, bson.D{}, opts)
+		assert.Nil(mt, err, "Find error: %v", err)
+		defer cursor.Close(mtest.Background)
+
+		var expected int32 = 1
+		for cursor.Next(mtest.Background) {
+			got := cursor.Current.Lookup("x").Int32()
+			assert.Equal(mt, expected, got, "expected x %v, got %v", expected, got)
+			expected++
+		}
+		assert.Nil(mt, cursor.Err(), "cursor error: %v", cursor.Err())
+		assert.Equal(mt, int32(6), expected, "expected 5 documents, got %v", expected-1)
+	})
 }
 
 type tryNextCursor interface {
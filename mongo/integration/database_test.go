@@ -88,6 +88,42 @@ func TestDatabase(t *testing.T) {
 			assert.True(mt, ok, "expected command %v to contain a $readPreference document", evt.Command)
 			assert.Equal(mt, expected, actual, "expected $readPreference document %v, got %v", expected, actual)
 		})
+
+		retryOpts := mtest.NewOptions().
+			MinServerVersion("4.0").
+			Topologies(mtest.ReplicaSet).
+			ClientOptions(options.Client().SetRetryReads(true))
+		mt.RunOpts("retryable", retryOpts, func(mt *mtest.T) {
+			failPoint := mtest.FailPoint{
+				ConfigureFailPoint: "failCommand",
+				Mode: mtest.FailPointMode{
+					Times: 1,
+				},
+				Data: mtest.FailPointData{
+					FailCommands:    []string{"dbStats"},
+					CloseConnection: true,
+				},
+			}
+
+			mt.Run("retried if retryable is set", func(mt *mtest.T) {
+				mt.SetFailPoint(failPoint)
+				mt.ClearEvents()
+
+				err := mt.DB.RunCommand(mtest.Background, bson.D{{"dbStats", 1}}, options.RunCmd().SetRetryable(true)).Err()
+				assert.Nil(mt, err, "RunCommand error: %v", err)
+				assert.NotNil(mt, mt.GetStartedEvent(), "expected first dbStats started event, got nil")
+				assert.NotNil(mt, mt.GetStartedEvent(), "expected retried dbStats started event, got nil")
+			})
+			mt.Run("not retried by default", func(mt *mtest.T) {
+				mt.SetFailPoint(failPoint)
+				mt.ClearEvents()
+
+				err := mt.DB.RunCommand(mtest.Background, bson.D{{"dbStats", 1}}).Err()
+				assert.NotNil(mt, err, "expected RunCommand error, got nil")
+				assert.NotNil(mt, mt.GetStartedEvent(), "expected dbStats started event, got nil")
+				assert.Nil(mt, mt.GetStartedEvent(), "expected no retry, got another started event")
+			})
+		})
 	})
 
 	dropOpts := mtest.NewOptions().DatabaseName("dropDb")
@@ -325,6 +361,26 @@ func TestDatabase(t *testing.T) {
 		}
 	})
 
+	mt.RunOpts("current op", mtest.NewOptions().MinServerVersion("3.6"), func(mt *mtest.T) {
+		// The $currentOp aggregation reports itself, so filtering for it should always return at least one operation.
+		opts := options.CurrentOp().
+			SetAllUsers(true).
+			SetFilter(bson.D{{"command.aggregate", 1}, {"command.pipeline.0.$currentOp", bson.D{{"$exists", true}}}})
+		mt.ClearEvents()
+
+		ops, err := mt.DB.CurrentOp(mtest.Background, opts)
+		assert.Nil(mt, err, "CurrentOp error: %v", err)
+		assert.True(mt, len(ops) > 0, "expected at least one operation, got none")
+		assert.Equal(mt, "command", ops[0].Op, "expected op %q, got %q", "command", ops[0].Op)
+		assert.NotNil(mt, ops[0].Command, "expected command document, got nil")
+
+		evt := mt.GetStartedEvent()
+		assert.Equal(mt, "admin", evt.DatabaseName, "expected database %q, got %q", "admin", evt.DatabaseName)
+		allUsers, ok := evt.Command.Lookup("pipeline", "0", "$currentOp", "allUsers").BooleanOK()
+		assert.True(mt, ok, "expected allUsers in command %v", evt.Command)
+		assert.True(mt, allUsers, "expected allUsers to be true, got false")
+	})
+
 	mt.RunOpts("create collection", noClientOpts, func(mt *mtest.T) {
 		collectionName := "create-collection-test"
 
@@ -245,6 +245,12 @@ func TestGridFS(x *testing.T) {
 						actualFile := downloadStream.GetFile()
 						assert.Equal(mt, expectedFile, actualFile, "expected file %v, got %v", expectedFile, actualFile)
 					})
+					mt.RunOpts("FindFiles", noClientOpts, func(mt *mtest.T) {
+						files, err := bucket.FindFiles(mtest.Background, bson.D{{"filename", fileName}})
+						assert.Nil(mt, err, "FindFiles error: %v", err)
+						assert.Equal(mt, 1, len(files), "expected 1 file, got %v", len(files))
+						assert.Equal(mt, expectedFile, files[0], "expected file %v, got %v", expectedFile, files[0])
+					})
 				})
 			}
 		})
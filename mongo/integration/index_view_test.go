@@ -165,6 +165,22 @@ func TestIndexView(t *testing.T) {
 				Value: true,
 			})
 		})
+		mt.RunOpts("comment", mtest.NewOptions().MinServerVersion("4.4"), func(mt *mtest.T) {
+			model := mongo.IndexModel{Keys: bson.D{{"x", 1}}}
+			createOpts := options.CreateIndexes().SetComment("migration-1")
+			mt.ClearEvents()
+
+			_, err := mt.Coll.Indexes().CreateOne(mtest.Background, model, createOpts)
+			assert.Nil(mt, err, "CreateOne error: %v", err)
+
+			evt := mt.GetStartedEvent()
+			assert.Equal(mt, "createIndexes", evt.CommandName, "expected command %q, got %q", "createIndexes",
+				evt.CommandName)
+			comment, err := evt.Command.LookupErr("comment")
+			assert.Nil(mt, err, "expected comment in command %s", evt.Command)
+			assert.Equal(mt, "migration-1", comment.StringValue(), "expected comment %q, got %q", "migration-1",
+				comment.StringValue())
+		})
 		mt.Run("nil keys", func(mt *mtest.T) {
 			_, err := mt.Coll.Indexes().CreateOne(mtest.Background, mongo.IndexModel{
 				Keys: nil,
@@ -353,6 +369,23 @@ func TestIndexView(t *testing.T) {
 			assert.Equal(mt, int64(100), maxTimeMS, "expected maxTimeMS value to be 100, got %d", maxTimeMS)
 		})
 	})
+	mt.RunOpts("index usage stats", mtest.NewOptions().MinServerVersion("3.2"), func(mt *mtest.T) {
+		_, err := mt.Coll.Indexes().CreateOne(mtest.Background, mongo.IndexModel{Keys: bson.D{{"x", 1}}})
+		assert.Nil(mt, err, "CreateOne error: %v", err)
+		_, err = mt.Coll.Find(mtest.Background, bson.D{{"x", 1}}, options.Find().SetHint("x_1"))
+		assert.Nil(mt, err, "Find error: %v", err)
+
+		stats, err := mt.Coll.IndexUsageStats(mtest.Background)
+		assert.Nil(mt, err, "IndexUsageStats error: %v", err)
+		ops := make(map[string]int64)
+		for _, usage := range stats {
+			assert.False(mt, usage.Since.IsZero(), "expected Since to be set for index %v", usage.Name)
+			ops[usage.Name] += usage.AccessOps
+		}
+		_, ok := ops["_id_"]
+		assert.True(mt, ok, "expected stats for index _id_, got %v", stats)
+		assert.True(mt, ops["x_1"] > 0, "expected index x_1 to have been used, got %v accesses", ops["x_1"])
+	})
 	mt.Run("drop one", func(mt *mtest.T) {
 		iv := mt.Coll.Indexes()
 		indexNames, err := iv.CreateMany(mtest.Background, []mongo.IndexModel{
@@ -405,6 +438,39 @@ func TestIndexView(t *testing.T) {
 		}
 		assert.Nil(mt, cursor.Err(), "cursor error: %v", cursor.Err())
 	})
+	mt.RunOpts("drop with comment", mtest.NewOptions().MinServerVersion("4.4"), func(mt *mtest.T) {
+		iv := mt.Coll.Indexes()
+		name, err := iv.CreateOne(mtest.Background, mongo.IndexModel{Keys: bson.D{{"foo", -1}}})
+		assert.Nil(mt, err, "CreateOne error: %v", err)
+		mt.ClearEvents()
+
+		_, err = iv.DropOne(mtest.Background, name, options.DropIndexes().SetComment(bson.D{{"migration", 1}}))
+		assert.Nil(mt, err, "DropOne error: %v", err)
+
+		evt := mt.GetStartedEvent()
+		assert.Equal(mt, "dropIndexes", evt.CommandName, "expected command %q, got %q", "dropIndexes", evt.CommandName)
+		comment, err := evt.Command.LookupErr("comment")
+		assert.Nil(mt, err, "expected comment in command %s", evt.Command)
+		migration := comment.Document().Lookup("migration").Int32()
+		assert.Equal(mt, int32(1), migration, "expected comment migration %v, got %v", 1, migration)
+	})
+	mt.RunOpts("list with comment and batch size", mtest.NewOptions().MinServerVersion("4.4"), func(mt *mtest.T) {
+		iv := mt.Coll.Indexes()
+		_, err := iv.CreateOne(mtest.Background, mongo.IndexModel{Keys: bson.D{{"foo", -1}}})
+		assert.Nil(mt, err, "CreateOne error: %v", err)
+		mt.ClearEvents()
+
+		cursor, err := iv.List(mtest.Background, options.ListIndexes().SetComment("list indexes").SetBatchSize(1))
+		assert.Nil(mt, err, "List error: %v", err)
+		defer cursor.Close(mtest.Background)
+
+		evt := mt.GetStartedEvent()
+		assert.Equal(mt, "listIndexes", evt.CommandName, "expected command %q, got %q", "listIndexes", evt.CommandName)
+		comment := evt.Command.Lookup("comment").StringValue()
+		assert.Equal(mt, "list indexes", comment, "expected comment %q, got %q", "list indexes", comment)
+		batchSize := evt.Command.Lookup("cursor", "batchSize").Int32()
+		assert.Equal(mt, int32(1), batchSize, "expected batchSize %v, got %v", 1, batchSize)
+	})
 }
 
 func getIndexDoc(mt *mtest.T, iv mongo.IndexView, expectedKeyDoc bson.D) bson.D {
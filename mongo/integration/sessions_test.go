@@ -17,6 +17,7 @@ import (
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
 	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readconcern"
 	"go.mongodb.org/mongo-driver/mongo/readpref"
 	"go.mongodb.org/mongo-driver/x/mongo/driver/session"
 )
@@ -327,6 +328,68 @@ func TestSessions(t *testing.T) {
 			assert.Nil(mt, err, "CommitTransaction error: %v", err)
 			assertCollectionCount(mt, int64(numDocs))
 		})
+		recoveryTokenOpts := mtest.NewOptions().MinServerVersion("4.2").Topologies(mtest.Sharded)
+		mt.RunOpts("recovery token", recoveryTokenOpts, func(mt *mtest.T) {
+			sess, err := mt.Client.StartSession()
+			assert.Nil(mt, err, "StartSession error: %v", err)
+			defer sess.EndSession(mtest.Background)
+			sessCtx := mongo.NewSessionContext(mtest.Background, sess)
+
+			err = sess.StartTransaction()
+			assert.Nil(mt, err, "StartTransaction error: %v", err)
+			_, ok := sess.RecoveryToken()
+			assert.False(mt, ok, "expected no recovery token before the first command")
+
+			_, err = mt.Coll.InsertOne(sessCtx, bson.D{{"x", 1}})
+			assert.Nil(mt, err, "InsertOne error: %v", err)
+			token, ok := sess.RecoveryToken()
+			assert.True(mt, ok, "expected recovery token after the first command")
+			assert.NotNil(mt, token, "expected non-nil recovery token")
+
+			err = sess.CommitTransaction(sessCtx)
+			assert.Nil(mt, err, "CommitTransaction error: %v", err)
+		})
+		mt.RunOpts("read concern only sent on first command", txnOpts, func(mt *mtest.T) {
+			// Test that the transaction's read concern is only attached to the first command in the transaction.
+
+			sess, err := mt.Client.StartSession()
+			assert.Nil(mt, err, "StartSession error: %v", err)
+			defer sess.EndSession(mtest.Background)
+			sessCtx := mongo.NewSessionContext(mtest.Background, sess)
+
+			assert.False(mt, sess.WillStartTransactionCommand(), "expected WillStartTransactionCommand to be false "+
+				"before the transaction is started")
+			err = sess.StartTransaction(options.Transaction().SetReadConcern(readconcern.Snapshot()))
+			assert.Nil(mt, err, "StartTransaction error: %v", err)
+			assert.True(mt, sess.WillStartTransactionCommand(), "expected WillStartTransactionCommand to be true "+
+				"after the transaction is started")
+
+			mt.ClearEvents()
+			_, err = mt.Coll.InsertOne(sessCtx, bson.D{{"x", 1}})
+			assert.Nil(mt, err, "InsertOne error: %v", err)
+			assert.False(mt, sess.WillStartTransactionCommand(), "expected WillStartTransactionCommand to be false "+
+				"after the first command")
+			err = mt.Coll.FindOne(sessCtx, bson.D{}).Err()
+			assert.Nil(mt, err, "FindOne error: %v", err)
+			_, err = mt.Coll.CountDocuments(sessCtx, bson.D{})
+			assert.Nil(mt, err, "CountDocuments error: %v", err)
+			err = sess.CommitTransaction(sessCtx)
+			assert.Nil(mt, err, "CommitTransaction error: %v", err)
+
+			var numReadConcerns int
+			for _, evt := range mt.GetAllStartedEvents() {
+				if _, err := evt.Command.LookupErr("readConcern"); err == nil {
+					numReadConcerns++
+				}
+			}
+			assert.Equal(mt, 1, numReadConcerns, "expected read concern to be sent 1 time, got %v", numReadConcerns)
+
+			first := mt.GetAllStartedEvents()[0]
+			assert.Equal(mt, "insert", first.CommandName, "expected first command %q, got %q", "insert",
+				first.CommandName)
+			level := first.Command.Lookup("readConcern", "level").StringValue()
+			assert.Equal(mt, "snapshot", level, "expected read concern level %q, got %q", "snapshot", level)
+		})
 	})
 }
 
@@ -0,0 +1,206 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"fmt"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
+)
+
+// systemVariables contains the variables that are always available in aggregation expressions.
+var systemVariables = map[string]struct{}{
+	"NOW":          {},
+	"CLUSTER_TIME": {},
+	"ROOT":         {},
+	"CURRENT":      {},
+	"REMOVE":       {},
+	"DESCEND":      {},
+	"PRUNE":        {},
+	"KEEP":         {},
+	"SEARCH_META":  {},
+	"USER_ROLES":   {},
+}
+
+// validateLetVariables returns an error if a stage in pipeline references a variable that is not a system variable,
+// is not declared in let, and is not defined by an operator within the pipeline. Variables defined by $let, $map,
+// $filter, $reduce, and the let option of $lookup are treated as declared for the whole pipeline rather than only for
+// the expressions in their scope, so this never reports a variable that the server would accept. Strings in a $literal
+// expression and in the query of a $match stage outside of $expr are not expressions, so they are not checked.
+func validateLetVariables(pipeline bsoncore.Document, let bsoncore.Document) error {
+	declared := make(map[string]struct{})
+	if let != nil {
+		if err := addDocumentKeys(declared, let); err != nil {
+			return err
+		}
+	}
+
+	stages, err := pipeline.Values()
+	if err != nil {
+		return err
+	}
+	for _, stage := range stages {
+		if err = collectDefinedVariables(declared, stage); err != nil {
+			return err
+		}
+	}
+
+	for idx, stage := range stages {
+		if name, ok := findUndeclaredVariable(declared, stage); ok {
+			return fmt.Errorf("pipeline stage %d references undeclared variable $$%s", idx, name)
+		}
+	}
+	return nil
+}
+
+// collectDefinedVariables adds the names of all variables defined by operators within val to defined.
+func collectDefinedVariables(defined map[string]struct{}, val bsoncore.Value) error {
+	switch val.Type {
+	case bsontype.Array:
+		vals, err := val.Array().Values()
+		if err != nil {
+			return err
+		}
+		for _, v := range vals {
+			if err = collectDefinedVariables(defined, v); err != nil {
+				return err
+			}
+		}
+	case bsontype.EmbeddedDocument:
+		elems, err := val.Document().Elements()
+		if err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			if elem.Key() == "$literal" {
+				continue
+			}
+			if err = addOperatorVariables(defined, elem); err != nil {
+				return err
+			}
+			if err = collectDefinedVariables(defined, elem.Value()); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// addOperatorVariables adds the variables defined by the operator in elem, if any, to defined.
+func addOperatorVariables(defined map[string]struct{}, elem bsoncore.Element) error {
+	args, ok := elem.Value().DocumentOK()
+	if !ok {
+		return nil
+	}
+
+	switch elem.Key() {
+	case "$let":
+		if vars, ok := args.Lookup("vars").DocumentOK(); ok {
+			return addDocumentKeys(defined, vars)
+		}
+	case "$lookup":
+		if vars, ok := args.Lookup("let").DocumentOK(); ok {
+			return addDocumentKeys(defined, vars)
+		}
+	case "$map", "$filter":
+		name := "this"
+		if as, ok := args.Lookup("as").StringValueOK(); ok {
+			name = as
+		}
+		defined[name] = struct{}{}
+	case "$reduce":
+		defined["value"] = struct{}{}
+		defined["this"] = struct{}{}
+	}
+	return nil
+}
+
+// findUndeclaredVariable returns the name of the first variable referenced in val that is not declared.
+func findUndeclaredVariable(declared map[string]struct{}, val bsoncore.Value) (string, bool) {
+	switch val.Type {
+	case bsontype.String:
+		s := val.StringValue()
+		if !strings.HasPrefix(s, "$$") {
+			return "", false
+		}
+		name := s[2:]
+		if idx := strings.IndexByte(name, '.'); idx >= 0 {
+			name = name[:idx]
+		}
+		if _, ok := systemVariables[name]; ok {
+			return "", false
+		}
+		if _, ok := declared[name]; ok {
+			return "", false
+		}
+		return name, true
+	case bsontype.Array:
+		vals, _ := val.Array().Values()
+		for _, v := range vals {
+			if name, ok := findUndeclaredVariable(declared, v); ok {
+				return name, true
+			}
+		}
+	case bsontype.EmbeddedDocument:
+		elems, _ := val.Document().Elements()
+		for _, elem := range elems {
+			var name string
+			var ok bool
+			switch elem.Key() {
+			case "$literal":
+				continue
+			case "$match":
+				name, ok = findUndeclaredQueryVariable(declared, elem.Value())
+			default:
+				name, ok = findUndeclaredVariable(declared, elem.Value())
+			}
+			if ok {
+				return name, true
+			}
+		}
+	}
+	return "", false
+}
+
+// findUndeclaredQueryVariable returns the name of the first variable referenced in the $expr expressions of the query
+// val that is not declared. Values outside of $expr are compared literally by the server, so they are not checked.
+func findUndeclaredQueryVariable(declared map[string]struct{}, val bsoncore.Value) (string, bool) {
+	switch val.Type {
+	case bsontype.Array:
+		vals, _ := val.Array().Values()
+		for _, v := range vals {
+			if name, ok := findUndeclaredQueryVariable(declared, v); ok {
+				return name, true
+			}
+		}
+	case bsontype.EmbeddedDocument:
+		elems, _ := val.Document().Elements()
+		for _, elem := range elems {
+			find := findUndeclaredQueryVariable
+			if elem.Key() == "$expr" {
+				find = findUndeclaredVariable
+			}
+			if name, ok := find(declared, elem.Value()); ok {
+				return name, true
+			}
+		}
+	}
+	return "", false
+}
+
+func addDocumentKeys(keys map[string]struct{}, doc bsoncore.Document) error {
+	elems, err := doc.Elements()
+	if err != nil {
+		return err
+	}
+	for _, elem := range elems {
+		keys[elem.Key()] = struct{}{}
+	}
+	return nil
+}
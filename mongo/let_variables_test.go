@@ -0,0 +1,110 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"strings"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/internal/testutil/assert"
+	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
+)
+
+func TestValidateLetVariables(t *testing.T) {
+	testCases := []struct {
+		name     string
+		pipeline interface{}
+		let      interface{}
+		errMsg   string
+	}{
+		{
+			"declared in let",
+			Pipeline{{{"$match", bson.D{{"$expr", bson.D{{"$eq", bson.A{"$x", "$$target.value"}}}}}}}},
+			bson.D{{"target", bson.D{{"value", 1}}}},
+			"",
+		},
+		{
+			"system variables",
+			Pipeline{{{"$project", bson.D{{"now", "$$NOW"}, {"doc", "$$ROOT"}, {"gone", "$$REMOVE"}}}}},
+			nil,
+			"",
+		},
+		{
+			"defined by operators",
+			Pipeline{
+				{{"$project", bson.D{
+					{"doubled", bson.D{{"$map", bson.D{{"input", "$xs"}, {"as", "x"}, {"in", "$$x"}}}}},
+					{"big", bson.D{{"$filter", bson.D{{"input", "$xs"}, {"cond", "$$this"}}}}},
+					{"sum", bson.D{{"$reduce", bson.D{{"input", "$xs"}, {"initialValue", 0}, {"in", bson.A{"$$value", "$$this"}}}}}},
+					{"y", bson.D{{"$let", bson.D{{"vars", bson.D{{"v", 1}}}, {"in", "$$v"}}}}},
+				}}},
+				{{"$lookup", bson.D{
+					{"from", "other"},
+					{"let", bson.D{{"id", "$_id"}}},
+					{"pipeline", bson.A{bson.D{{"$match", bson.D{{"$expr", bson.D{{"$eq", bson.A{"$ref", "$$id"}}}}}}}}},
+					{"as", "joined"},
+				}}},
+			},
+			nil,
+			"",
+		},
+		{
+			"literal",
+			Pipeline{{{"$project", bson.D{
+				{"price", bson.D{{"$literal", "$$1.00"}}},
+				{"doc", bson.D{{"$literal", bson.D{{"v", "$$x"}}}}},
+			}}}},
+			nil,
+			"",
+		},
+		{
+			"match query outside of $expr",
+			Pipeline{{{"$match", bson.D{{"price", "$$1.00"}, {"tags", bson.D{{"$in", bson.A{"$$a", "$$b"}}}}}}}},
+			nil,
+			"",
+		},
+		{
+			"undeclared in match $expr",
+			Pipeline{{{"$match", bson.D{
+				{"price", "$$1.00"},
+				{"$and", bson.A{bson.D{{"$expr", bson.D{{"$eq", bson.A{"$x", "$$missing"}}}}}}},
+			}}}},
+			nil,
+			"pipeline stage 0 references undeclared variable $$missing",
+		},
+		{
+			"undeclared",
+			Pipeline{
+				{{"$match", bson.D{{"x", 1}}}},
+				{{"$project", bson.D{{"y", "$$missing.field"}}}},
+			},
+			bson.D{{"declared", 1}},
+			"pipeline stage 1 references undeclared variable $$missing",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pipeline, _, err := transformAggregatePipelinev2(nil, tc.pipeline)
+			assert.Nil(t, err, "transformAggregatePipelinev2 error: %v", err)
+			var let bsoncore.Document
+			if tc.let != nil {
+				let, err = transformBsoncoreDocument(nil, tc.let)
+				assert.Nil(t, err, "transformBsoncoreDocument error: %v", err)
+			}
+
+			err = validateLetVariables(pipeline, let)
+			if tc.errMsg == "" {
+				assert.Nil(t, err, "validateLetVariables error: %v", err)
+				return
+			}
+			assert.NotNil(t, err, "expected validateLetVariables error, got nil")
+			assert.True(t, strings.Contains(err.Error(), tc.errMsg), "expected error %q to contain %q", err.Error(),
+				tc.errMsg)
+		})
+	}
+}
@@ -0,0 +1,235 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/event"
+	"go.mongodb.org/mongo-driver/mongo/description"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// logger emits log messages for the driver components configured in an options.LoggerOptions. Messages are produced
+// by wrapping the command, pool, and server monitors so that user-provided monitors continue to receive every event.
+type logger struct {
+	opts *options.LoggerOptions
+	sink options.LogSink
+}
+
+func newLogger(opts *options.LoggerOptions) *logger {
+	sink := opts.Sink
+	if sink == nil {
+		sink = stderrLogSink{log.New(os.Stderr, "", log.LstdFlags)}
+	}
+	return &logger{opts: opts, sink: sink}
+}
+
+func (l *logger) enabled(component options.LogComponent, level options.LogLevel) bool {
+	return l.opts.Level(component) >= level
+}
+
+func (l *logger) debug(component options.LogComponent, msg string, keysAndValues ...interface{}) {
+	if l.enabled(component, options.LogLevelDebug) {
+		l.sink.Info(int(options.LogLevelDebug), msg, append(keysAndValues, "component", string(component))...)
+	}
+}
+
+func (l *logger) error(component options.LogComponent, err error, msg string, keysAndValues ...interface{}) {
+	if l.enabled(component, options.LogLevelInfo) {
+		l.sink.Error(err, msg, append(keysAndValues, "component", string(component))...)
+	}
+}
+
+// commandMonitor returns a CommandMonitor that logs command events and then forwards them to inner.
+func (l *logger) commandMonitor(inner *event.CommandMonitor) *event.CommandMonitor {
+	if !l.enabled(options.LogComponentCommand, options.LogLevelInfo) {
+		return inner
+	}
+
+	return &event.CommandMonitor{
+		Started: func(ctx context.Context, evt *event.CommandStartedEvent) {
+			l.debug(options.LogComponentCommand, "Command started",
+				"commandName", evt.CommandName,
+				"databaseName", evt.DatabaseName,
+				"requestId", evt.RequestID,
+				"connectionId", evt.ConnectionID,
+			)
+			if inner != nil && inner.Started != nil {
+				inner.Started(ctx, evt)
+			}
+		},
+		Succeeded: func(ctx context.Context, evt *event.CommandSucceededEvent) {
+			l.debug(options.LogComponentCommand, "Command succeeded",
+				"commandName", evt.CommandName,
+				"requestId", evt.RequestID,
+				"connectionId", evt.ConnectionID,
+				"duration", time.Duration(evt.DurationNanos),
+			)
+			if inner != nil && inner.Succeeded != nil {
+				inner.Succeeded(ctx, evt)
+			}
+		},
+		Failed: func(ctx context.Context, evt *event.CommandFailedEvent) {
+			l.error(options.LogComponentCommand, errors.New(evt.Failure), "Command failed",
+				"commandName", evt.CommandName,
+				"requestId", evt.RequestID,
+				"connectionId", evt.ConnectionID,
+				"duration", time.Duration(evt.DurationNanos),
+			)
+			if inner != nil && inner.Failed != nil {
+				inner.Failed(ctx, evt)
+			}
+		},
+	}
+}
+
+// poolMonitor returns a PoolMonitor that logs connection pool events and then forwards them to inner.
+func (l *logger) poolMonitor(inner *event.PoolMonitor) *event.PoolMonitor {
+	if !l.enabled(options.LogComponentConnection, options.LogLevelInfo) {
+		return inner
+	}
+
+	return &event.PoolMonitor{
+		Event: func(evt *event.PoolEvent) {
+			keysAndValues := []interface{}{"address", evt.Address, "connectionId", evt.ConnectionID}
+			if evt.Reason != "" {
+				keysAndValues = append(keysAndValues, "reason", evt.Reason)
+			}
+			if evt.Type == event.GetFailed {
+				l.error(options.LogComponentConnection, errors.New(evt.Reason), evt.Type, keysAndValues...)
+			} else {
+				l.debug(options.LogComponentConnection, evt.Type, keysAndValues...)
+			}
+			if inner != nil && inner.Event != nil {
+				inner.Event(evt)
+			}
+		},
+	}
+}
+
+// serverMonitor returns a ServerMonitor that logs server and topology events and then forwards them to inner.
+func (l *logger) serverMonitor(inner *event.ServerMonitor) *event.ServerMonitor {
+	if !l.enabled(options.LogComponentTopology, options.LogLevelInfo) {
+		return inner
+	}
+	if inner == nil {
+		inner = &event.ServerMonitor{}
+	}
+
+	return &event.ServerMonitor{
+		ServerDescriptionChanged: func(evt *event.ServerDescriptionChangedEvent) {
+			l.debug(options.LogComponentTopology, "Server description changed",
+				"address", evt.Address.String(),
+				"previousKind", evt.PreviousDescription.Kind.String(),
+				"newKind", evt.NewDescription.Kind.String(),
+			)
+			if inner.ServerDescriptionChanged != nil {
+				inner.ServerDescriptionChanged(evt)
+			}
+		},
+		ServerOpening: func(evt *event.ServerOpeningEvent) {
+			l.debug(options.LogComponentTopology, "Server opening", "address", evt.Address.String())
+			if inner.ServerOpening != nil {
+				inner.ServerOpening(evt)
+			}
+		},
+		ServerClosed: func(evt *event.ServerClosedEvent) {
+			l.debug(options.LogComponentTopology, "Server closed", "address", evt.Address.String())
+			if inner.ServerClosed != nil {
+				inner.ServerClosed(evt)
+			}
+		},
+		TopologyDescriptionChanged: func(evt *event.TopologyDescriptionChangedEvent) {
+			l.debug(options.LogComponentTopology, "Topology description changed",
+				"previousKind", evt.PreviousDescription.Kind.String(),
+				"newKind", evt.NewDescription.Kind.String(),
+			)
+			if inner.TopologyDescriptionChanged != nil {
+				inner.TopologyDescriptionChanged(evt)
+			}
+		},
+		TopologyOpening: func(evt *event.TopologyOpeningEvent) {
+			l.debug(options.LogComponentTopology, "Topology opening", "topologyId", evt.TopologyID.Hex())
+			if inner.TopologyOpening != nil {
+				inner.TopologyOpening(evt)
+			}
+		},
+		TopologyClosed: func(evt *event.TopologyClosedEvent) {
+			l.debug(options.LogComponentTopology, "Topology closed", "topologyId", evt.TopologyID.Hex())
+			if inner.TopologyClosed != nil {
+				inner.TopologyClosed(evt)
+			}
+		},
+		ServerHeartbeatStarted: func(evt *event.ServerHeartbeatStartedEvent) {
+			l.debug(options.LogComponentTopology, "Server heartbeat started",
+				"connectionId", evt.ConnectionID,
+				"awaited", evt.Awaited,
+			)
+			if inner.ServerHeartbeatStarted != nil {
+				inner.ServerHeartbeatStarted(evt)
+			}
+		},
+		ServerHeartbeatSucceeded: func(evt *event.ServerHeartbeatSucceededEvent) {
+			l.debug(options.LogComponentTopology, "Server heartbeat succeeded",
+				"connectionId", evt.ConnectionID,
+				"awaited", evt.Awaited,
+				"duration", time.Duration(evt.DurationNanos),
+			)
+			if inner.ServerHeartbeatSucceeded != nil {
+				inner.ServerHeartbeatSucceeded(evt)
+			}
+		},
+		ServerHeartbeatFailed: func(evt *event.ServerHeartbeatFailedEvent) {
+			l.error(options.LogComponentTopology, evt.Failure, "Server heartbeat failed",
+				"connectionId", evt.ConnectionID,
+				"awaited", evt.Awaited,
+				"duration", time.Duration(evt.DurationNanos),
+			)
+			if inner.ServerHeartbeatFailed != nil {
+				inner.ServerHeartbeatFailed(evt)
+			}
+		},
+	}
+}
+
+// serverSelected logs the outcome of a server selection attempt.
+func (l *logger) serverSelected(selected description.Server, err error) {
+	if err != nil {
+		l.error(options.LogComponentServerSelection, err, "Server selection failed")
+		return
+	}
+	l.debug(options.LogComponentServerSelection, "Server selection succeeded", "address", selected.Addr.String())
+}
+
+// stderrLogSink is the LogSink used when no sink is configured.
+type stderrLogSink struct {
+	l *log.Logger
+}
+
+func (s stderrLogSink) Info(_ int, msg string, keysAndValues ...interface{}) {
+	s.l.Print(formatLogMessage(msg, keysAndValues))
+}
+
+func (s stderrLogSink) Error(err error, msg string, keysAndValues ...interface{}) {
+	s.l.Print(formatLogMessage(msg, append(keysAndValues, "error", err)))
+}
+
+func formatLogMessage(msg string, keysAndValues []interface{}) string {
+	var sb strings.Builder
+	sb.WriteString(msg)
+	for i := 0; i+1 < len(keysAndValues); i += 2 {
+		fmt.Fprintf(&sb, " %v=%v", keysAndValues[i], keysAndValues[i+1])
+	}
+	return sb.String()
+}
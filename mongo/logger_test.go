@@ -0,0 +1,73 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/event"
+	"go.mongodb.org/mongo-driver/internal/testutil/assert"
+	"go.mongodb.org/mongo-driver/mongo/description"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type testLogSink struct {
+	infos  []string
+	errors []string
+}
+
+func (s *testLogSink) Info(_ int, msg string, _ ...interface{}) {
+	s.infos = append(s.infos, msg)
+}
+
+func (s *testLogSink) Error(_ error, msg string, _ ...interface{}) {
+	s.errors = append(s.errors, msg)
+}
+
+func TestLogger(t *testing.T) {
+	t.Run("disabled component returns inner monitor", func(t *testing.T) {
+		inner := &event.CommandMonitor{}
+		l := newLogger(options.Logger().SetComponentLevel(options.LogComponentTopology, options.LogLevelDebug))
+		got := l.commandMonitor(inner)
+		assert.True(t, got == inner, "expected inner monitor to be returned")
+	})
+	t.Run("command events are logged and forwarded", func(t *testing.T) {
+		sink := &testLogSink{}
+		var started int
+		inner := &event.CommandMonitor{
+			Started: func(context.Context, *event.CommandStartedEvent) { started++ },
+		}
+		l := newLogger(options.Logger().SetComponentLevel(options.LogComponentCommand, options.LogLevelDebug).SetSink(sink))
+		monitor := l.commandMonitor(inner)
+
+		monitor.Started(context.Background(), &event.CommandStartedEvent{CommandName: "find"})
+		monitor.Succeeded(context.Background(), &event.CommandSucceededEvent{})
+		monitor.Failed(context.Background(), &event.CommandFailedEvent{Failure: "failed"})
+		assert.Equal(t, 1, started, "expected inner Started to be called once, got %v", started)
+		assert.Equal(t, []string{"Command started", "Command succeeded"}, sink.infos, "unexpected info messages")
+		assert.Equal(t, []string{"Command failed"}, sink.errors, "unexpected error messages")
+	})
+	t.Run("info level only logs failures", func(t *testing.T) {
+		sink := &testLogSink{}
+		l := newLogger(options.Logger().SetComponentLevel(options.LogComponentAll, options.LogLevelInfo).SetSink(sink))
+
+		l.serverSelected(description.Server{}, nil)
+		l.serverSelected(description.Server{}, errors.New("selection failed"))
+		assert.Equal(t, 0, len(sink.infos), "expected no info messages, got %v", sink.infos)
+		assert.Equal(t, []string{"Server selection failed"}, sink.errors, "unexpected error messages")
+	})
+	t.Run("component level overrides all", func(t *testing.T) {
+		opts := options.Logger().
+			SetComponentLevel(options.LogComponentAll, options.LogLevelDebug).
+			SetComponentLevel(options.LogComponentConnection, options.LogLevelOff)
+		l := newLogger(opts)
+		assert.True(t, l.enabled(options.LogComponentCommand, options.LogLevelDebug), "expected command to be enabled")
+		assert.False(t, l.enabled(options.LogComponentConnection, options.LogLevelInfo), "expected connection to be disabled")
+	})
+}
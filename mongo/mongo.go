@@ -15,6 +15,7 @@ import (
 	"strconv"
 	"strings"
 
+	"go.mongodb.org/mongo-driver/internal"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.mongodb.org/mongo-driver/x/bsonx"
 	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
@@ -72,6 +73,17 @@ func (me MarshalError) Error() string {
 //
 type Pipeline []bson.D
 
+// WithOperationName returns a copy of ctx that carries an application-level name for the operations run with it, such
+// as "CreateOrder". The name is reported in the OperationName field of the CommandStartedEvent for every command sent
+// using the returned context. This can be used to correlate command monitoring events with the application operations
+// that caused them.
+func WithOperationName(ctx context.Context, name string) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return internal.WithOperationName(ctx, name)
+}
+
 // transformAndEnsureID is a hack that makes it easy to get a RawValue as the _id value. This will
 // be removed when we switch from using bsonx to bsoncore for the driver package.
 func transformAndEnsureID(registry *bsoncodec.Registry, val interface{}) (bsonx.Doc, interface{}, error) {
@@ -293,6 +305,18 @@ func transformAggregatePipeline(registry *bsoncodec.Registry, pipeline interface
 	return pipelineArr, nil
 }
 
+// outputStageKey returns the name of the stage and true if stage is a $out or $merge stage.
+func outputStageKey(stage bsoncore.Document) (string, bool) {
+	elem, err := stage.IndexErr(0)
+	if err != nil {
+		return "", false
+	}
+	if key := elem.Key(); key == "$out" || key == "$merge" {
+		return key, true
+	}
+	return "", false
+}
+
 func transformAggregatePipelinev2(registry *bsoncodec.Registry, pipeline interface{}) (bsoncore.Document, bool, error) {
 	switch t := pipeline.(type) {
 	case bsoncodec.ValueMarshaler:
@@ -304,13 +328,27 @@ func transformAggregatePipelinev2(registry *bsoncodec.Registry, pipeline interfa
 			return nil, false, fmt.Errorf("ValueMarshaler returned a %v, but was expecting %v", btype, bsontype.Array)
 		}
 
-		var hasOutputStage bool
 		pipelineDoc := bsoncore.Document(val)
-		if _, err := pipelineDoc.LookupErr("$out"); err == nil {
-			hasOutputStage = true
+		stages, err := pipelineDoc.Values()
+		if err != nil {
+			return nil, false, err
 		}
-		if _, err := pipelineDoc.LookupErr("$merge"); err == nil {
-			hasOutputStage = true
+
+		// The pipeline document is an array, so its keys are indexes. The stages must be inspected individually to find
+		// a $out or $merge stage, which requires the aggregation to be routed to the primary.
+		var hasOutputStage bool
+		for idx, stage := range stages {
+			doc, ok := stage.DocumentOK()
+			if !ok {
+				continue
+			}
+			if key, ok := outputStageKey(doc); ok {
+				if idx != len(stages)-1 {
+					return nil, false, fmt.Errorf("%s stage must be the last stage in an aggregation pipeline, but was at index %d",
+						key, idx)
+				}
+				hasOutputStage = true
+			}
 		}
 
 		return pipelineDoc, hasOutputStage, nil
@@ -329,10 +367,12 @@ func transformAggregatePipelinev2(registry *bsoncodec.Registry, pipeline interfa
 				return nil, false, err
 			}
 
-			if idx == valLen-1 {
-				if elem, err := doc.IndexErr(0); err == nil && (elem.Key() == "$out" || elem.Key() == "$merge") {
-					hasOutputStage = true
+			if key, ok := outputStageKey(doc); ok {
+				if idx != valLen-1 {
+					return nil, false, fmt.Errorf("%s stage must be the last stage in an aggregation pipeline, but was at index %d",
+						key, idx)
 				}
+				hasOutputStage = true
 			}
 			arr = bsoncore.AppendDocumentElement(arr, strconv.Itoa(idx), doc)
 		}
@@ -441,6 +481,21 @@ func transformValue(registry *bsoncodec.Registry, val interface{}) (bsoncore.Val
 	return bsoncore.Value{Type: bsonType, Data: bsonValue}, nil
 }
 
+// transformHint marshals hint and ensures that it is either an index name or an index specification document. This is
+// used for the hint option of every operation so all of them accept the same forms.
+func transformHint(registry *bsoncodec.Registry, hint interface{}) (bsoncore.Value, error) {
+	hintVal, err := transformValue(registry, hint)
+	if err != nil {
+		return bsoncore.Value{}, err
+	}
+	switch hintVal.Type {
+	case bsontype.String, bsontype.EmbeddedDocument:
+		return hintVal, nil
+	default:
+		return bsoncore.Value{}, ErrInvalidHint
+	}
+}
+
 // Build the aggregation pipeline for the CountDocument command.
 func countDocumentsAggregatePipeline(registry *bsoncodec.Registry, filter interface{}, opts *options.CountOptions) (bsoncore.Document, error) {
 	filterDoc, err := transformBsoncoreDocument(registry, filter)
@@ -274,6 +274,38 @@ func TestMongoHelpers(t *testing.T) {
 			})
 		}
 	})
+	t.Run("transform aggregate pipeline output stage", func(t *testing.T) {
+		out := bson.D{{"$out", bson.D{{"db", "db"}, {"coll", "coll"}}}}
+		match := bson.D{{"$match", bson.D{{"x", 1}}}}
+		matchDoc := bsonx.Doc{{"$match", bsonx.Document(bsonx.Doc{{"x", bsonx.Int32(1)}})}}
+		mergeDoc := bsonx.Doc{{"$merge", bsonx.Document(bsonx.Doc{{"into", bsonx.String("coll")}})}}
+
+		testCases := []struct {
+			name           string
+			pipeline       interface{}
+			hasOutputStage bool
+			errExpected    bool
+		}{
+			{"no output stage", Pipeline{match}, false, false},
+			{"output stage last", Pipeline{match, out}, true, false},
+			{"output stage not last", Pipeline{out, match}, false, true},
+			{"ValueMarshaler no output stage", bsonx.Arr{bsonx.Document(matchDoc)}, false, false},
+			{"ValueMarshaler output stage last", bsonx.Arr{bsonx.Document(matchDoc), bsonx.Document(mergeDoc)}, true, false},
+			{"ValueMarshaler output stage not last", bsonx.Arr{bsonx.Document(mergeDoc), bsonx.Document(matchDoc)}, false, true},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				_, hasOutputStage, err := transformAggregatePipelinev2(bson.DefaultRegistry, tc.pipeline)
+				if tc.errExpected {
+					assert.NotNil(t, err, "expected error, got nil")
+					return
+				}
+				assert.Nil(t, err, "transformAggregatePipelinev2 error: %v", err)
+				assert.Equal(t, tc.hasOutputStage, hasOutputStage,
+					"expected hasOutputStage %v, got %v", tc.hasOutputStage, hasOutputStage)
+			})
+		}
+	})
 	t.Run("transform value", func(t *testing.T) {
 		valueMarshaler := bvMarsh{
 			t:    bsontype.String,
@@ -301,6 +333,36 @@ func TestMongoHelpers(t *testing.T) {
 					return
 				}
 
+				assert.Equal(t, tc.bsonType, res.Type, "expected BSON type %s, got %s", tc.bsonType, res.Type)
+				assert.Equal(t, tc.bsonValue, res.Data, "expected BSON data %v, got %v", tc.bsonValue, res.Data)
+			})
+		}
+	})
+	t.Run("transform hint", func(t *testing.T) {
+		doc := bson.D{{"x", 1}}
+		docBytes, _ := bson.Marshal(doc)
+
+		testCases := []struct {
+			name      string
+			hint      interface{}
+			err       error
+			bsonType  bsontype.Type
+			bsonValue []byte
+		}{
+			{"nil", nil, ErrNilValue, 0, nil},
+			{"index name", "x_1", nil, bsontype.String, bsoncore.AppendString(nil, "x_1")},
+			{"index specification", doc, nil, bsontype.EmbeddedDocument, docBytes},
+			{"raw index specification", bson.Raw(docBytes), nil, bsontype.EmbeddedDocument, docBytes},
+			{"invalid type", 1, ErrInvalidHint, 0, nil},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				res, err := transformHint(nil, tc.hint)
+				if tc.err != nil {
+					assert.Equal(t, tc.err, err, "expected error %v, got %v", tc.err, err)
+					return
+				}
+
 				assert.Equal(t, tc.bsonType, res.Type, "expected BSON type %s, got %s", tc.bsonType, res.Type)
 				assert.Equal(t, tc.bsonValue, res.Data, "expected BSON data %v, got %v", tc.bsonValue, res.Data)
 			})
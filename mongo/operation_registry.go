@@ -0,0 +1,60 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"context"
+	"sync"
+)
+
+// operationRegistry tracks the operations that are currently executing so they can be cancelled together.
+type operationRegistry struct {
+	mu     sync.Mutex
+	nextID uint64
+	ops    map[uint64]context.CancelFunc
+}
+
+func newOperationRegistry() *operationRegistry {
+	return &operationRegistry{
+		ops: make(map[uint64]context.CancelFunc),
+	}
+}
+
+// track registers an operation and returns a cancellable context for it. The returned function must be called when
+// the operation completes.
+func (r *operationRegistry) track(ctx context.Context) (context.Context, func()) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	r.mu.Lock()
+	id := r.nextID
+	r.nextID++
+	r.ops[id] = cancel
+	r.mu.Unlock()
+
+	return ctx, func() {
+		r.mu.Lock()
+		delete(r.ops, id)
+		r.mu.Unlock()
+		cancel()
+	}
+}
+
+// len returns the number of operations currently registered.
+func (r *operationRegistry) len() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.ops)
+}
+
+// cancelAll cancels the contexts of all registered operations.
+func (r *operationRegistry) cancelAll() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, cancel := range r.ops {
+		cancel()
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"context"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/internal/testutil/assert"
+)
+
+func TestOperationRegistry(t *testing.T) {
+	t.Run("track and done", func(t *testing.T) {
+		r := newOperationRegistry()
+		ctx1, done1 := r.track(context.Background())
+		_, done2 := r.track(context.Background())
+		assert.Equal(t, 2, r.len(), "expected 2 operations, got %v", r.len())
+
+		done1()
+		assert.Equal(t, 1, r.len(), "expected 1 operation, got %v", r.len())
+		assert.Equal(t, context.Canceled, ctx1.Err(), "expected context to be cancelled after done, got %v", ctx1.Err())
+		done2()
+		assert.Equal(t, 0, r.len(), "expected 0 operations, got %v", r.len())
+	})
+	t.Run("cancel all", func(t *testing.T) {
+		r := newOperationRegistry()
+		ctx1, done1 := r.track(context.Background())
+		defer done1()
+		ctx2, done2 := r.track(context.Background())
+		defer done2()
+
+		r.cancelAll()
+		assert.Equal(t, context.Canceled, ctx1.Err(), "expected first context to be cancelled, got %v", ctx1.Err())
+		assert.Equal(t, context.Canceled, ctx2.Err(), "expected second context to be cancelled, got %v", ctx2.Err())
+
+		ctx3, done3 := r.track(context.Background())
+		defer done3()
+		assert.Nil(t, ctx3.Err(), "expected new context to not be cancelled, got %v", ctx3.Err())
+	})
+}
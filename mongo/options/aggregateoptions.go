@@ -6,7 +6,11 @@
 
 package options
 
-import "time"
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo/readconcern"
+)
 
 // AggregateOptions represents options that can be used to configure an Aggregate operation.
 type AggregateOptions struct {
@@ -44,6 +48,33 @@ type AggregateOptions struct {
 	// as a document. The hint does not apply to $lookup and $graphLookup aggregation stages. The default value is nil,
 	// which means that no hint will be sent.
 	Hint interface{}
+
+	// Specifies parameters for the aggregate expression. This option is only valid for MongoDB versions >= 5.0. Older
+	// servers will report an error for using this option. This must be a document mapping parameter names to values.
+	// Values must be constant or closed expressions that do not reference document fields. Parameters can then be
+	// accessed as variables in an aggregate expression context (e.g. "$$var"). The default value is nil, which means
+	// that no parameters will be sent.
+	Let interface{}
+
+	// If true, the driver will check that every variable referenced in the pipeline (e.g. "$$var") is either declared
+	// in Let, defined within the pipeline by an operator such as $let, $map, $filter, $reduce, or a $lookup let, or is
+	// a system variable such as $$NOW or $$ROOT. If a variable is undeclared, the driver will return an error naming
+	// the variable and the index of the stage referencing it without sending the command. Strings in a $literal
+	// expression and in a $match query outside of $expr are not treated as variable references. The default value is
+	// false.
+	ValidateLetVariables *bool
+
+	// If true, the driver will issue the getMore for the next batch of results in a background goroutine while the
+	// current batch is being iterated, so the next batch is usually available as soon as the current one is exhausted.
+	// This requires memory for up to two batches at a time. The option is ignored if the operation is run with an
+	// explicit session. The default value is false.
+	ReadAhead *bool
+
+	// Specifies the read concern for this operation, overriding the read concern of the collection. This option cannot
+	// be used for operations run in a transaction, because the read concern of a transaction is set when the
+	// transaction is started. The "snapshot" level is only valid outside of a transaction for MongoDB versions >= 5.0.
+	// The default value is nil, which means the read concern of the collection will be used.
+	ReadConcern *readconcern.ReadConcern
 }
 
 // Aggregate creates a new AggregateOptions instance.
@@ -99,6 +130,30 @@ func (ao *AggregateOptions) SetHint(h interface{}) *AggregateOptions {
 	return ao
 }
 
+// SetLet sets the value for the Let field.
+func (ao *AggregateOptions) SetLet(let interface{}) *AggregateOptions {
+	ao.Let = let
+	return ao
+}
+
+// SetValidateLetVariables sets the value for the ValidateLetVariables field.
+func (ao *AggregateOptions) SetValidateLetVariables(b bool) *AggregateOptions {
+	ao.ValidateLetVariables = &b
+	return ao
+}
+
+// SetReadAhead sets the value for the ReadAhead field.
+func (ao *AggregateOptions) SetReadAhead(b bool) *AggregateOptions {
+	ao.ReadAhead = &b
+	return ao
+}
+
+// SetReadConcern sets the value for the ReadConcern field.
+func (ao *AggregateOptions) SetReadConcern(rc *readconcern.ReadConcern) *AggregateOptions {
+	ao.ReadConcern = rc
+	return ao
+}
+
 // MergeAggregateOptions combines the given AggregateOptions instances into a single AggregateOptions in a last-one-wins
 // fashion.
 func MergeAggregateOptions(opts ...*AggregateOptions) *AggregateOptions {
@@ -131,6 +186,18 @@ func MergeAggregateOptions(opts ...*AggregateOptions) *AggregateOptions {
 		if ao.Hint != nil {
 			aggOpts.Hint = ao.Hint
 		}
+		if ao.Let != nil {
+			aggOpts.Let = ao.Let
+		}
+		if ao.ValidateLetVariables != nil {
+			aggOpts.ValidateLetVariables = ao.ValidateLetVariables
+		}
+		if ao.ReadAhead != nil {
+			aggOpts.ReadAhead = ao.ReadAhead
+		}
+		if ao.ReadConcern != nil {
+			aggOpts.ReadConcern = ao.ReadConcern
+		}
 	}
 
 	return aggOpts
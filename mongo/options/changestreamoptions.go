@@ -22,6 +22,11 @@ type ChangeStreamOptions struct {
 	// default value is nil, which means the default collation of the collection will be used.
 	Collation *Collation
 
+	// A string or document that will be included in server logs, profiling logs, and currentOp queries to help trace
+	// the operation. The comment is sent with the initial aggregate command and, for MongoDB versions >= 4.4, with
+	// every getMore command issued by the change stream. The default is nil, which means that no comment will be sent.
+	Comment interface{}
+
 	// Specifies whether the updated document should be returned in change notifications for update operations along
 	// with the deltas describing the changes made to the document. The default is options.Default, which means that
 	// the updated document will not be included in the change notification.
@@ -30,6 +35,15 @@ type ChangeStreamOptions struct {
 	// The maximum amount of time that the server should wait for new documents to satisfy a tailable cursor query.
 	MaxAwaitTime *time.Duration
 
+	// If true, the change stream will be re-opened automatically after an "invalidate" event is returned, which
+	// happens when the watched collection is dropped or renamed, or the watched database is dropped. The invalidate
+	// event is still returned by Next and TryNext, and the stream is then re-opened using the event's resume token as
+	// the StartAfter option, so it will report changes for the namespace once it is recreated. As with resuming after
+	// an error, no events are skipped, but an application that restarts from a resume token it stored may see events
+	// again, so delivery is at-least-once. This option is only valid for MongoDB versions >= 4.2. For previous server
+	// versions, the change stream is not re-opened. The default value is false.
+	ReopenOnInvalidate *bool
+
 	// A document specifying the logical starting point for the change stream. Only changes corresponding to an oplog
 	// entry immediately after the resume token will be returned. If this is specified, StartAtOperationTime and
 	// StartAfter must not be set.
@@ -67,6 +81,12 @@ func (cso *ChangeStreamOptions) SetCollation(c Collation) *ChangeStreamOptions {
 	return cso
 }
 
+// SetComment sets the value for the Comment field.
+func (cso *ChangeStreamOptions) SetComment(comment interface{}) *ChangeStreamOptions {
+	cso.Comment = comment
+	return cso
+}
+
 // SetFullDocument sets the value for the FullDocument field.
 func (cso *ChangeStreamOptions) SetFullDocument(fd FullDocument) *ChangeStreamOptions {
 	cso.FullDocument = &fd
@@ -79,6 +99,12 @@ func (cso *ChangeStreamOptions) SetMaxAwaitTime(d time.Duration) *ChangeStreamOp
 	return cso
 }
 
+// SetReopenOnInvalidate sets the value for the ReopenOnInvalidate field.
+func (cso *ChangeStreamOptions) SetReopenOnInvalidate(b bool) *ChangeStreamOptions {
+	cso.ReopenOnInvalidate = &b
+	return cso
+}
+
 // SetResumeAfter sets the value for the ResumeAfter field.
 func (cso *ChangeStreamOptions) SetResumeAfter(rt interface{}) *ChangeStreamOptions {
 	cso.ResumeAfter = rt
@@ -111,12 +137,18 @@ func MergeChangeStreamOptions(opts ...*ChangeStreamOptions) *ChangeStreamOptions
 		if cso.Collation != nil {
 			csOpts.Collation = cso.Collation
 		}
+		if cso.Comment != nil {
+			csOpts.Comment = cso.Comment
+		}
 		if cso.FullDocument != nil {
 			csOpts.FullDocument = cso.FullDocument
 		}
 		if cso.MaxAwaitTime != nil {
 			csOpts.MaxAwaitTime = cso.MaxAwaitTime
 		}
+		if cso.ReopenOnInvalidate != nil {
+			csOpts.ReopenOnInvalidate = cso.ReopenOnInvalidate
+		}
 		if cso.ResumeAfter != nil {
 			csOpts.ResumeAfter = cso.ResumeAfter
 		}
@@ -16,11 +16,16 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net"
+	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/bsoncodec"
 	"go.mongodb.org/mongo-driver/event"
+	"go.mongodb.org/mongo-driver/mongo/address"
+	"go.mongodb.org/mongo-driver/mongo/description"
 	"go.mongodb.org/mongo-driver/mongo/readconcern"
 	"go.mongodb.org/mongo-driver/mongo/readpref"
 	"go.mongodb.org/mongo-driver/mongo/writeconcern"
@@ -38,6 +43,19 @@ type ContextDialer interface {
 	DialContext(ctx context.Context, network, address string) (net.Conn, error)
 }
 
+// maxZstdLevel is the highest compression level supported by the zstd compressor.
+const maxZstdLevel = 22
+
+// SeedOrder specifies the order in which the servers in the seed list are opened when a Client connects.
+type SeedOrder int8
+
+const (
+	// Randomized opens the servers in the seed list in a random order.
+	Randomized SeedOrder = iota
+	// AsProvided opens the servers in the seed list in the order they were specified.
+	AsProvided
+)
+
 // Credential can be used to provide authentication options when configuring a Client.
 //
 // AuthMechanism: the mechanism to use for authentication. Supported values include "SCRAM-SHA-256", "SCRAM-SHA-1",
@@ -95,29 +113,40 @@ type ClientOptions struct {
 	AppName                  *string
 	Auth                     *Credential
 	AutoEncryptionOptions    *AutoEncryptionOptions
+	ClusterTimeObserver      func(address.Address, bson.Raw)
 	ConnectTimeout           *time.Duration
 	Compressors              []string
 	Dialer                   ContextDialer
 	Direct                   *bool
 	DisableOCSPEndpointCheck *bool
+	FailFastWhenNoServer     *bool
 	HeartbeatInterval        *time.Duration
 	Hosts                    []string
 	LocalThreshold           *time.Duration
+	LoggerOptions            *LoggerOptions
 	MaxConnIdleTime          *time.Duration
 	MaxPoolSize              *uint64
+	MaxPoolSizePerServerKind map[description.ServerKind]uint64
 	MinPoolSize              *uint64
 	PoolMonitor              *event.PoolMonitor
+	ProxyURL                 *string
 	Monitor                  *event.CommandMonitor
 	ServerMonitor            *event.ServerMonitor
 	ReadConcern              *readconcern.ReadConcern
 	ReadPreference           *readpref.ReadPref
 	Registry                 *bsoncodec.Registry
+	RejectServerSideJS       *bool
 	ReplicaSet               *string
 	RetryReads               *bool
 	RetryWrites              *bool
+	RetryWritesTimeout       *time.Duration
+	SeedListOrder            *SeedOrder
 	ServerSelectionTimeout   *time.Duration
 	SocketTimeout            *time.Duration
+	Timeout                  *time.Duration
 	TLSConfig                *tls.Config
+	ValidateDocumentSize     *bool
+	WaitForConnection        *time.Duration
 	WriteConcern             *writeconcern.WriteConcern
 	ZlibLevel                *int
 	ZstdLevel                *int
@@ -166,6 +195,59 @@ func (c *ClientOptions) validateAndSetError() {
 			return
 		}
 	}
+
+	if c.ProxyURL != nil {
+		proxyURL, err := url.Parse(*c.ProxyURL)
+		if err != nil {
+			c.err = fmt.Errorf("invalid proxy URL: %v", err)
+			return
+		}
+		if proxyURL.Scheme != "http" || proxyURL.Host == "" {
+			c.err = errors.New("invalid proxy URL: the URL must have the form http://[username:password@]host[:port]")
+			return
+		}
+	}
+
+	if c.ZlibLevel != nil && (*c.ZlibLevel < -1 || *c.ZlibLevel > 9) {
+		c.err = fmt.Errorf("invalid zlib compression level %d: the level must be between -1 and 9, inclusive", *c.ZlibLevel)
+		return
+	}
+	if c.ZstdLevel != nil && (*c.ZstdLevel < -1 || *c.ZstdLevel > maxZstdLevel) {
+		c.err = fmt.Errorf("invalid zstd compression level %d: the level must be between -1 and %d, inclusive",
+			*c.ZstdLevel, maxZstdLevel)
+		return
+	}
+
+	if c.Auth != nil {
+		if err := validateCredential(c.Auth); err != nil {
+			c.err = err
+			return
+		}
+	}
+}
+
+// validateCredential checks that the mechanism properties and username/password combination in cred are valid for
+// its authentication mechanism. Only mechanisms whose properties can be set through typed helpers are checked here;
+// the remaining mechanisms are validated when the connection string is parsed or when the authenticator is created.
+func validateCredential(cred *Credential) error {
+	switch strings.ToUpper(cred.AuthMechanism) {
+	case "MONGODB-AWS":
+		if cred.Username != "" && cred.Password == "" {
+			return errors.New("username without password is invalid for MONGODB-AWS")
+		}
+		if cred.Username == "" && cred.Password != "" {
+			return errors.New("password without username is invalid for MONGODB-AWS")
+		}
+		for k := range cred.AuthMechanismProperties {
+			if k != "AWS_SESSION_TOKEN" {
+				return fmt.Errorf("invalid auth property %q for MONGODB-AWS", k)
+			}
+			if cred.Username == "" {
+				return errors.New("token without username and password is invalid for MONGODB-AWS")
+			}
+		}
+	}
+	return nil
 }
 
 // GetURI returns the original URI used to configure the ClientOptions instance. If ApplyURI was not called during
@@ -300,6 +382,18 @@ func (c *ClientOptions) ApplyURI(uri string) *ClientOptions {
 		c.RetryReads = &cs.RetryReads
 	}
 
+	if cs.ProxyHost != "" {
+		proxyURL := url.URL{Scheme: "http", Host: cs.ProxyHost}
+		if cs.ProxyPortSet {
+			proxyURL.Host = net.JoinHostPort(cs.ProxyHost, strconv.Itoa(cs.ProxyPort))
+		}
+		if cs.ProxyUsername != "" {
+			proxyURL.User = url.UserPassword(cs.ProxyUsername, cs.ProxyPassword)
+		}
+		proxyStr := proxyURL.String()
+		c.ProxyURL = &proxyStr
+	}
+
 	if cs.ReplicaSet != "" {
 		c.ReplicaSet = &cs.ReplicaSet
 	}
@@ -312,6 +406,10 @@ func (c *ClientOptions) ApplyURI(uri string) *ClientOptions {
 		c.SocketTimeout = &cs.SocketTimeout
 	}
 
+	if cs.TimeoutSet {
+		c.Timeout = &cs.Timeout
+	}
+
 	if cs.SSL {
 		tlsConfig := new(tls.Config)
 
@@ -398,11 +496,61 @@ func (c *ClientOptions) SetAppName(s string) *ClientOptions {
 // SetAuth specifies a Credential containing options for configuring authentication. See the options.Credential
 // documentation for more information about Credential fields. The default is an empty Credential, meaning no
 // authentication will be configured.
+//
+// SetAuth replaces the whole Credential, including the mechanism properties set by earlier calls to
+// SetAuthMechanismProperties and SetAWSSessionToken, so those must be called after SetAuth.
 func (c *ClientOptions) SetAuth(auth Credential) *ClientOptions {
 	c.Auth = &auth
 	return c
 }
 
+// SetAuthMechanismProperties specifies additional configuration options for the authentication mechanism. See the
+// Credential documentation for the supported properties. If SetAuth has not been called, this creates an empty
+// Credential first. The given map replaces any properties that were previously set. This must be called after SetAuth,
+// which replaces the whole Credential.
+func (c *ClientOptions) SetAuthMechanismProperties(props map[string]string) *ClientOptions {
+	if c.Auth == nil {
+		c.Auth = &Credential{}
+	}
+	c.Auth.AuthMechanismProperties = props
+	return c
+}
+
+// SetAWSSessionToken specifies the AWS session token to use for MONGODB-AWS authentication with temporary
+// credentials. This sets the AWS_SESSION_TOKEN mechanism property and, if no mechanism has been specified, sets the
+// authentication mechanism to MONGODB-AWS. A session token requires a username (AWS access key ID) and password (AWS
+// secret access key) to also be specified on the Credential. This must be called after SetAuth, which replaces the
+// whole Credential.
+func (c *ClientOptions) SetAWSSessionToken(token string) *ClientOptions {
+	if c.Auth == nil {
+		c.Auth = &Credential{}
+	}
+	if c.Auth.AuthMechanism == "" {
+		c.Auth.AuthMechanism = "MONGODB-AWS"
+	}
+
+	props := make(map[string]string, len(c.Auth.AuthMechanismProperties)+1)
+	for k, v := range c.Auth.AuthMechanismProperties {
+		props[k] = v
+	}
+	props["AWS_SESSION_TOKEN"] = token
+	c.Auth.AuthMechanismProperties = props
+	return c
+}
+
+// SetClusterTimeObserver specifies a function to be called whenever a server response includes a $clusterTime. The
+// function is called with the address of the server that sent the response and the $clusterTime document, which
+// contains the "clusterTime" timestamp and the "signature" sub-document. This can be used to observe cluster time
+// gossip for tracing and causal coordination without wrapping every operation.
+//
+// The observer is called synchronously on the goroutine executing the operation, so it must return quickly and must
+// not modify the document. The document is only valid for the duration of the call; a copy must be made if it is
+// retained. The default is nil, which means no observer is called.
+func (c *ClientOptions) SetClusterTimeObserver(observer func(addr address.Address, clusterTime bson.Raw)) *ClientOptions {
+	c.ClusterTimeObserver = observer
+	return c
+}
+
 // SetCompressors sets the compressors that can be used when communicating with a server. Valid values are:
 //
 // 1. "snappy" - requires server version >= 3.4
@@ -462,6 +610,20 @@ func (c *ClientOptions) SetDirect(b bool) *ClientOptions {
 	return c
 }
 
+// SetFailFastWhenNoServer specifies whether operations should fail immediately with a server selection error if the
+// topology is in a settled state and contains no server that can satisfy the operation (e.g. a replica set with no
+// primary for a write), instead of waiting for the server selection timeout. The topology is considered settled once
+// every server has been checked and no servers have changed type for at least one heartbeat interval, so operations
+// still wait during initial discovery. A replica set without a primary is not considered settled while any secondary is
+// available, because one may still be elected, so writes wait for the server selection timeout during elections
+// however long they take. Fail fast applies when, for example, only arbiters or unreachable members remain. This
+// option is ignored if a custom Deployment is set. The default
+// is false.
+func (c *ClientOptions) SetFailFastWhenNoServer(b bool) *ClientOptions {
+	c.FailFastWhenNoServer = &b
+	return c
+}
+
 // SetHeartbeatInterval specifies the amount of time to wait between periodic background server checks. This can also be
 // set through the "heartbeatIntervalMS" URI option (e.g. "heartbeatIntervalMS=10000"). The default is 10 seconds.
 func (c *ClientOptions) SetHeartbeatInterval(d time.Duration) *ClientOptions {
@@ -488,9 +650,20 @@ func (c *ClientOptions) SetLocalThreshold(d time.Duration) *ClientOptions {
 	return c
 }
 
+// SetLoggerOptions specifies the LoggerOptions used to configure the driver's logging. Logging is disabled for every
+// component by default. Log messages are emitted in addition to the events published to the command, pool, and server
+// monitors.
+func (c *ClientOptions) SetLoggerOptions(opts *LoggerOptions) *ClientOptions {
+	c.LoggerOptions = opts
+	return c
+}
+
 // SetMaxConnIdleTime specifies the maximum amount of time that a connection will remain idle in a connection pool
 // before it is removed from the pool and closed. This can also be set through the "maxIdleTimeMS" URI option (e.g.
 // "maxIdleTimeMS=10000"). The default is 0, meaning a connection can remain unused indefinitely.
+//
+// Idle connections are closed by a background routine even if no connections are being checked out of the pool, and
+// a ConnectionClosed event with reason "idle" is published for each of them.
 func (c *ClientOptions) SetMaxConnIdleTime(d time.Duration) *ClientOptions {
 	c.MaxConnIdleTime = &d
 	return c
@@ -504,6 +677,17 @@ func (c *ClientOptions) SetMaxPoolSize(u uint64) *ClientOptions {
 	return c
 }
 
+// SetMaxPoolSizePerServerKind specifies the maximum number of connections allowed in the driver's connection pool to
+// each server based on the kind of the server, e.g. a larger pool for description.RSPrimary than for
+// description.RSSecondary. The limit for a server changes when its kind changes. If the limit is lowered while more
+// connections are in use, requests to the server will block until enough connections have been returned to the pool.
+// Kinds that are not in the map, including description.Unknown before a server has been discovered, use the
+// MaxPoolSize option. A limit of 0 means that the pool size is unbounded.
+func (c *ClientOptions) SetMaxPoolSizePerServerKind(sizes map[description.ServerKind]uint64) *ClientOptions {
+	c.MaxPoolSizePerServerKind = sizes
+	return c
+}
+
 // SetMinPoolSize specifies the minimum number of connections allowed in the driver's connection pool to each server. If
 // this is non-zero, each server's pool will be maintained in the background to ensure that the size does not fall below
 // the minimum. This can also be set through the "minPoolSize" URI option (e.g. "minPoolSize=100"). The default is 0.
@@ -532,6 +716,19 @@ func (c *ClientOptions) SetServerMonitor(m *event.ServerMonitor) *ClientOptions
 	return c
 }
 
+// SetProxyURL specifies the URL of an HTTP proxy that all connections to the servers will be tunneled through using the
+// CONNECT method. The URL must have the form "http://[username:password@]host[:port]". If a username and password are
+// specified, they are sent to the proxy using basic authentication. The port defaults to 80 if it is not specified.
+// The tunnel is established before the TLS handshake, so TLS is negotiated with the server and the proxy cannot read
+// the traffic. Connections made using a custom Dialer set through SetDialer are also tunneled through the proxy.
+//
+// This can also be set through the "proxyHost", "proxyPort", "proxyUsername", and "proxyPassword" URI options (e.g.
+// "proxyHost=proxy.example.com&proxyPort=3128"). The default is nil, meaning connections are made directly.
+func (c *ClientOptions) SetProxyURL(u string) *ClientOptions {
+	c.ProxyURL = &u
+	return c
+}
+
 // SetReadConcern specifies the read concern to use for read operations. A read concern level can also be set through
 // the "readConcernLevel" URI option (e.g. "readConcernLevel=majority"). The default is nil, meaning the server will use
 // its configured default.
@@ -567,6 +764,16 @@ func (c *ClientOptions) SetRegistry(registry *bsoncodec.Registry) *ClientOptions
 	return c
 }
 
+// SetRejectServerSideJavaScript specifies whether the driver should refuse to send commands that contain server-side
+// JavaScript operators. If true, every outgoing command is scanned for the $where, $function, and $accumulator
+// operators and an error is returned without sending the command if any of them are found. This is intended as a
+// defense-in-depth measure against accidental or injected server-side JavaScript execution and applies to filters,
+// pipelines, and any other part of the command, including commands run through RunCommand. The default is false.
+func (c *ClientOptions) SetRejectServerSideJavaScript(b bool) *ClientOptions {
+	c.RejectServerSideJS = &b
+	return c
+}
+
 // SetReplicaSet specifies the replica set name for the cluster. If specified, the cluster will be treated as a replica
 // set and the driver will automatically discover all servers in the set, starting with the nodes specified through
 // ApplyURI or SetHosts. All nodes in the replica set must have the same replica set name, or they will not be
@@ -594,6 +801,17 @@ func (c *ClientOptions) SetRetryWrites(b bool) *ClientOptions {
 	return c
 }
 
+// SetRetryWritesTimeout specifies the amount of time after the first attempt of a retryable write during which the
+// write can be retried. For a write that is split into several batches, such as InsertMany, it starts again with the
+// first attempt of each batch. Once this has elapsed, the error from the last attempt is returned instead of retrying
+// the write, even if the operation's timeout or context deadline has not expired. This has no effect if retryable writes
+// are disabled. The default is nil, meaning that retries are only bounded by the operation's timeout or context
+// deadline.
+func (c *ClientOptions) SetRetryWritesTimeout(d time.Duration) *ClientOptions {
+	c.RetryWritesTimeout = &d
+	return c
+}
+
 // SetRetryReads specifies whether supported read operations should be retried once on certain errors, such as network
 // errors.
 //
@@ -607,6 +825,15 @@ func (c *ClientOptions) SetRetryReads(b bool) *ClientOptions {
 	return c
 }
 
+// SetSeedListOrder specifies the order in which the servers in the seed list are opened for the initial discovery
+// of the deployment. Use AsProvided to open the seeds in the order they were specified through ApplyURI or
+// SetHosts, which is useful when one seed is preferred or when a deterministic order is needed for testing. Servers
+// discovered after the initial connection are not affected. The default is Randomized.
+func (c *ClientOptions) SetSeedListOrder(order SeedOrder) *ClientOptions {
+	c.SeedListOrder = &order
+	return c
+}
+
 // SetServerSelectionTimeout specifies how long the driver will wait to find an available, suitable server to execute an
 // operation. This can also be set through the "serverSelectionTimeoutMS" URI option (e.g.
 // "serverSelectionTimeoutMS=30000"). The default value is 30 seconds.
@@ -617,12 +844,40 @@ func (c *ClientOptions) SetServerSelectionTimeout(d time.Duration) *ClientOption
 
 // SetSocketTimeout specifies how long the driver will wait for a socket read or write to return before returning a
 // network error. This can also be set through the "socketTimeoutMS" URI option (e.g. "socketTimeoutMS=1000"). The
-// default value is 0, meaning no timeout is used and socket operations can block indefinitely.
+// default value is 0, meaning no timeout is used and socket operations can block indefinitely. This option is ignored
+// if a timeout is set via SetTimeout.
 func (c *ClientOptions) SetSocketTimeout(d time.Duration) *ClientOptions {
 	c.SocketTimeout = &d
 	return c
 }
 
+// SetTimeout specifies the amount of time that a single operation run on this Client can execute before returning an
+// error. The deadline covers server selection, connection checkout, socket reads and writes, and server execution, and
+// applies to the operation as a whole, including any retries. Cursor getMore commands are each given their own
+// deadline. If the Context passed to an operation already has a deadline, that deadline is used instead. When a
+// deadline is in effect and the command does not otherwise specify one, the driver derives a maxTimeMS value for the
+// server from the time remaining.
+//
+// If this option is set, it takes precedence over SocketTimeout, which will be ignored. Operation-level MaxTime
+// options are sent to the server as specified and replace this timeout for that operation. A value of 0
+// means that operations will not time out. This can also be set through the "timeoutMS" URI option (e.g.
+// "timeoutMS=1000"). The default value is nil, meaning operations are only limited by the Context and other timeouts.
+func (c *ClientOptions) SetTimeout(d time.Duration) *ClientOptions {
+	c.Timeout = &d
+	return c
+}
+
+// SetValidateDocumentSize specifies whether the driver should check the size of inserted documents before sending
+// them. If true, every document passed to an insert or to an InsertOneModel in a bulk write is compared against the
+// maximum document size reported by the selected server, and a driver.DocumentTooLargeError naming the index and size
+// of the first oversized document is returned without sending any of the documents. The error wraps
+// driver.ErrDocumentTooLarge. If false, documents are not checked and oversized documents are sent to the server,
+// which will reject them. The default is true.
+func (c *ClientOptions) SetValidateDocumentSize(b bool) *ClientOptions {
+	c.ValidateDocumentSize = &b
+	return c
+}
+
 // SetTLSConfig specifies a tls.Config instance to use use to configure TLS on all connections created to the cluster.
 // This can also be set through the following URI options:
 //
@@ -671,10 +926,20 @@ func (c *ClientOptions) SetWriteConcern(wc *writeconcern.WriteConcern) *ClientOp
 	return c
 }
 
+// SetWaitForConnection specifies how long Client.Connect should wait for a writable server to be discovered before
+// returning. If no writable server is found within the timeout, Connect disconnects the Client and returns an error
+// describing the current state of the topology. The default is nil, meaning Connect returns without waiting for server discovery. See Client.Ready
+// for more information.
+func (c *ClientOptions) SetWaitForConnection(d time.Duration) *ClientOptions {
+	c.WaitForConnection = &d
+	return c
+}
+
 // SetZlibLevel specifies the level for the zlib compressor. This option is ignored if zlib is not specified as a
 // compressor through ApplyURI or SetCompressors. Supported values are -1 through 9, inclusive. -1 tells the zlib
-// library to use its default, 0 means no compression, 1 means best speed, and 9 means best compression.
-// This can also be set through the "zlibCompressionLevel" URI option (e.g. "zlibCompressionLevel=-1"). Defaults to -1.
+// library to use its default, 0 means no compression, 1 means best speed, and 9 means best compression. Values outside
+// of this range will cause Client creation to fail. This can also be set through the "zlibCompressionLevel" URI option
+// (e.g. "zlibCompressionLevel=-1"). Defaults to 6, which is also the zlib library's default.
 func (c *ClientOptions) SetZlibLevel(level int) *ClientOptions {
 	c.ZlibLevel = &level
 
@@ -682,8 +947,11 @@ func (c *ClientOptions) SetZlibLevel(level int) *ClientOptions {
 }
 
 // SetZstdLevel sets the level for the zstd compressor. This option is ignored if zstd is not specified as a compressor
-// through ApplyURI or SetCompressors. Supported values are 1 through 20, inclusive. 1 means best speed and 20 means
-// best compression. This can also be set through the "zstdCompressionLevel" URI option. Defaults to 6.
+// through ApplyURI or SetCompressors. Supported values are -1 through 22, inclusive. -1 means the default level, lower
+// levels favor speed, and 22 means best compression. Values outside of this range will cause Client creation to fail.
+// This can also be set through the "zstdCompressionLevel" URI option, which accepts the same values. Defaults to 6.
+//
+// Levels cannot be configured for the snappy compressor.
 func (c *ClientOptions) SetZstdLevel(level int) *ClientOptions {
 	c.ZstdLevel = &level
 	return c
@@ -734,6 +1002,9 @@ func MergeClientOptions(opts ...*ClientOptions) *ClientOptions {
 		if opt.AuthenticateToAnything != nil {
 			c.AuthenticateToAnything = opt.AuthenticateToAnything
 		}
+		if opt.ClusterTimeObserver != nil {
+			c.ClusterTimeObserver = opt.ClusterTimeObserver
+		}
 		if opt.Compressors != nil {
 			c.Compressors = opt.Compressors
 		}
@@ -749,18 +1020,27 @@ func MergeClientOptions(opts ...*ClientOptions) *ClientOptions {
 		if opt.LocalThreshold != nil {
 			c.LocalThreshold = opt.LocalThreshold
 		}
+		if opt.LoggerOptions != nil {
+			c.LoggerOptions = opt.LoggerOptions
+		}
 		if opt.MaxConnIdleTime != nil {
 			c.MaxConnIdleTime = opt.MaxConnIdleTime
 		}
 		if opt.MaxPoolSize != nil {
 			c.MaxPoolSize = opt.MaxPoolSize
 		}
+		if opt.MaxPoolSizePerServerKind != nil {
+			c.MaxPoolSizePerServerKind = opt.MaxPoolSizePerServerKind
+		}
 		if opt.MinPoolSize != nil {
 			c.MinPoolSize = opt.MinPoolSize
 		}
 		if opt.PoolMonitor != nil {
 			c.PoolMonitor = opt.PoolMonitor
 		}
+		if opt.ProxyURL != nil {
+			c.ProxyURL = opt.ProxyURL
+		}
 		if opt.Monitor != nil {
 			c.Monitor = opt.Monitor
 		}
@@ -776,15 +1056,24 @@ func MergeClientOptions(opts ...*ClientOptions) *ClientOptions {
 		if opt.Registry != nil {
 			c.Registry = opt.Registry
 		}
+		if opt.RejectServerSideJS != nil {
+			c.RejectServerSideJS = opt.RejectServerSideJS
+		}
 		if opt.ReplicaSet != nil {
 			c.ReplicaSet = opt.ReplicaSet
 		}
 		if opt.RetryWrites != nil {
 			c.RetryWrites = opt.RetryWrites
 		}
+		if opt.RetryWritesTimeout != nil {
+			c.RetryWritesTimeout = opt.RetryWritesTimeout
+		}
 		if opt.RetryReads != nil {
 			c.RetryReads = opt.RetryReads
 		}
+		if opt.SeedListOrder != nil {
+			c.SeedListOrder = opt.SeedListOrder
+		}
 		if opt.ServerSelectionTimeout != nil {
 			c.ServerSelectionTimeout = opt.ServerSelectionTimeout
 		}
@@ -794,9 +1083,18 @@ func MergeClientOptions(opts ...*ClientOptions) *ClientOptions {
 		if opt.SocketTimeout != nil {
 			c.SocketTimeout = opt.SocketTimeout
 		}
+		if opt.Timeout != nil {
+			c.Timeout = opt.Timeout
+		}
+		if opt.ValidateDocumentSize != nil {
+			c.ValidateDocumentSize = opt.ValidateDocumentSize
+		}
 		if opt.TLSConfig != nil {
 			c.TLSConfig = opt.TLSConfig
 		}
+		if opt.WaitForConnection != nil {
+			c.WaitForConnection = opt.WaitForConnection
+		}
 		if opt.WriteConcern != nil {
 			c.WriteConcern = opt.WriteConcern
 		}
@@ -815,6 +1113,9 @@ func MergeClientOptions(opts ...*ClientOptions) *ClientOptions {
 		if opt.DisableOCSPEndpointCheck != nil {
 			c.DisableOCSPEndpointCheck = opt.DisableOCSPEndpointCheck
 		}
+		if opt.FailFastWhenNoServer != nil {
+			c.FailFastWhenNoServer = opt.FailFastWhenNoServer
+		}
 		if opt.err != nil {
 			c.err = opt.err
 		}
@@ -7,6 +7,8 @@
 package options
 
 import (
+	"time"
+
 	"go.mongodb.org/mongo-driver/bson/bsoncodec"
 	"go.mongodb.org/mongo-driver/mongo/readconcern"
 	"go.mongodb.org/mongo-driver/mongo/readpref"
@@ -30,6 +32,13 @@ type CollectionOptions struct {
 	// The BSON registry to marshal and unmarshal documents for operations executed on the Collection. The default value
 	// is nil, which means that the registry of the database used to configure the Collection will be used.
 	Registry *bsoncodec.Registry
+
+	// The timeout to use for operations executed on the Collection if the context passed to the operation does not have
+	// a deadline. The default value is nil, which means that the timeout of the database used to configure the
+	// Collection will be used. A value of 0 means that there is no timeout. The precedence for bounding an operation is:
+	// a deadline on the context, then the operation's maxTime option (e.g. FindOptions.MaxTime), then this timeout,
+	// then the database's DefaultTimeout, then the client's Timeout.
+	DefaultTimeout *time.Duration
 }
 
 // Collection creates a new CollectionOptions instance.
@@ -61,6 +70,12 @@ func (c *CollectionOptions) SetRegistry(r *bsoncodec.Registry) *CollectionOption
 	return c
 }
 
+// SetDefaultTimeout sets the value for the DefaultTimeout field.
+func (c *CollectionOptions) SetDefaultTimeout(timeout time.Duration) *CollectionOptions {
+	c.DefaultTimeout = &timeout
+	return c
+}
+
 // MergeCollectionOptions combines the given CollectionOptions instances into a single *CollectionOptions in a
 // last-one-wins fashion.
 func MergeCollectionOptions(opts ...*CollectionOptions) *CollectionOptions {
@@ -82,6 +97,9 @@ func MergeCollectionOptions(opts ...*CollectionOptions) *CollectionOptions {
 		if opt.Registry != nil {
 			c.Registry = opt.Registry
 		}
+		if opt.DefaultTimeout != nil {
+			c.DefaultTimeout = opt.DefaultTimeout
+		}
 	}
 
 	return c
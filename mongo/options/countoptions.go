@@ -6,7 +6,11 @@
 
 package options
 
-import "time"
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo/readconcern"
+)
 
 // CountOptions represents options that can be used to configure a CountDocuments operation.
 type CountOptions struct {
@@ -16,7 +20,8 @@ type CountOptions struct {
 	Collation *Collation
 
 	// The index to use for the aggregation. This should either be the index name as a string or the index specification
-	// as a document. The default value is nil, which means that no hint will be sent.
+	// as a document. The hint is sent with the aggregation even if the filter is empty, because CountDocuments never
+	// uses collection metadata to count. The default value is nil, which means that no hint will be sent.
 	Hint interface{}
 
 	// The maximum number of documents to count. The default value is 0, which means that there is no limit and all
@@ -27,6 +32,12 @@ type CountOptions struct {
 	// no time limit for query execution.
 	MaxTime *time.Duration
 
+	// Specifies the read concern for this operation, overriding the read concern of the collection. This option cannot
+	// be used for operations run in a transaction, because the read concern of a transaction is set when the
+	// transaction is started. The "snapshot" level is only valid outside of a transaction for MongoDB versions >= 5.0.
+	// The default value is nil, which means the read concern of the collection will be used.
+	ReadConcern *readconcern.ReadConcern
+
 	// The number of documents to skip before counting. The default value is 0.
 	Skip *int64
 }
@@ -60,6 +71,12 @@ func (co *CountOptions) SetMaxTime(d time.Duration) *CountOptions {
 	return co
 }
 
+// SetReadConcern sets the value for the ReadConcern field.
+func (co *CountOptions) SetReadConcern(rc *readconcern.ReadConcern) *CountOptions {
+	co.ReadConcern = rc
+	return co
+}
+
 // SetSkip sets the value for the Skip field.
 func (co *CountOptions) SetSkip(i int64) *CountOptions {
 	co.Skip = &i
@@ -85,6 +102,9 @@ func MergeCountOptions(opts ...*CountOptions) *CountOptions {
 		if co.MaxTime != nil {
 			countOpts.MaxTime = co.MaxTime
 		}
+		if co.ReadConcern != nil {
+			countOpts.ReadConcern = co.ReadConcern
+		}
 		if co.Skip != nil {
 			countOpts.Skip = co.Skip
 		}
@@ -40,6 +40,12 @@ type CreateCollectionOptions struct {
 	// >= 3.4. The default value is nil, meaning indexes will be configured using server defaults.
 	DefaultIndexOptions *DefaultIndexOptions
 
+	// Specifies the encrypted fields of a Queryable Encryption collection. The value must be a document in the form
+	// {fields: [<field>, ...]} (see https://www.mongodb.com/docs/manual/core/queryable-encryption/). If set, the
+	// encrypted state collections and an index on the __safeContent__ field are created along with the collection.
+	// This option is only valid for MongoDB versions >= 6.0. The default value is nil.
+	EncryptedFields interface{}
+
 	// Specifies the maximum number of documents allowed in a capped collection. The limit specified by the SizeInBytes
 	// option takes precedence over this option. If a capped collection reaches its size limit, old documents will be
 	// removed, regardless of the number of documents in the collection. The default value is 0, meaning the maximum
@@ -95,6 +101,12 @@ func (c *CreateCollectionOptions) SetDefaultIndexOptions(opts *DefaultIndexOptio
 	return c
 }
 
+// SetEncryptedFields sets the value for the EncryptedFields field.
+func (c *CreateCollectionOptions) SetEncryptedFields(encryptedFields interface{}) *CreateCollectionOptions {
+	c.EncryptedFields = encryptedFields
+	return c
+}
+
 // SetMaxDocuments sets the value for the MaxDocuments field.
 func (c *CreateCollectionOptions) SetMaxDocuments(max int64) *CreateCollectionOptions {
 	c.MaxDocuments = &max
@@ -150,6 +162,9 @@ func MergeCreateCollectionOptions(opts ...*CreateCollectionOptions) *CreateColle
 		if opt.DefaultIndexOptions != nil {
 			cc.DefaultIndexOptions = opt.DefaultIndexOptions
 		}
+		if opt.EncryptedFields != nil {
+			cc.EncryptedFields = opt.EncryptedFields
+		}
 		if opt.MaxDocuments != nil {
 			cc.MaxDocuments = opt.MaxDocuments
 		}
@@ -0,0 +1,68 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package options
+
+// CurrentOpOptions represents options that can be used to configure a Database.CurrentOp operation.
+type CurrentOpOptions struct {
+	// If true, operations for all users will be returned. If false, only the current user's operations will be
+	// returned. Returning operations for all users requires the inprog privilege. The default value is false.
+	AllUsers *bool
+
+	// If true, idle connections will be included in the results in addition to active operations. The default value
+	// is false.
+	IdleConnections *bool
+
+	// A document containing query operators that is used to select which operations are returned. It is added to the
+	// aggregation as a $match stage after the $currentOp stage. The default value is nil, which means all operations
+	// will be returned.
+	Filter interface{}
+}
+
+// CurrentOp creates a new CurrentOpOptions instance.
+func CurrentOp() *CurrentOpOptions {
+	return &CurrentOpOptions{}
+}
+
+// SetAllUsers sets the value for the AllUsers field.
+func (co *CurrentOpOptions) SetAllUsers(b bool) *CurrentOpOptions {
+	co.AllUsers = &b
+	return co
+}
+
+// SetIdleConnections sets the value for the IdleConnections field.
+func (co *CurrentOpOptions) SetIdleConnections(b bool) *CurrentOpOptions {
+	co.IdleConnections = &b
+	return co
+}
+
+// SetFilter sets the value for the Filter field.
+func (co *CurrentOpOptions) SetFilter(filter interface{}) *CurrentOpOptions {
+	co.Filter = filter
+	return co
+}
+
+// MergeCurrentOpOptions combines the given CurrentOpOptions instances into a single *CurrentOpOptions in a
+// last-one-wins fashion.
+func MergeCurrentOpOptions(opts ...*CurrentOpOptions) *CurrentOpOptions {
+	co := CurrentOp()
+	for _, opt := range opts {
+		if opt == nil {
+			continue
+		}
+		if opt.AllUsers != nil {
+			co.AllUsers = opt.AllUsers
+		}
+		if opt.IdleConnections != nil {
+			co.IdleConnections = opt.IdleConnections
+		}
+		if opt.Filter != nil {
+			co.Filter = opt.Filter
+		}
+	}
+
+	return co
+}
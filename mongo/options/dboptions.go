@@ -7,6 +7,8 @@
 package options
 
 import (
+	"time"
+
 	"go.mongodb.org/mongo-driver/bson/bsoncodec"
 	"go.mongodb.org/mongo-driver/mongo/readconcern"
 	"go.mongodb.org/mongo-driver/mongo/readpref"
@@ -30,6 +32,22 @@ type DatabaseOptions struct {
 	// The BSON registry to marshal and unmarshal documents for operations executed on the Database. The default value
 	// is nil, which means that the registry of the client used to configure the Database will be used.
 	Registry *bsoncodec.Registry
+
+	// If true, the implicit sessions created for operations executed on the Database will be causally consistent with
+	// each other, so a read will observe the results of earlier writes and reads executed on the same database
+	// through any Database instance with this option enabled. Operations on other databases are not affected. This
+	// option has no effect on operations that use an explicit session, which are governed by the CausalConsistency
+	// option of the session instead. The default value is false. See
+	// https://docs.mongodb.com/manual/core/read-isolation-consistency-recency/#causal-consistency for more
+	// information.
+	CausalConsistencyDefault *bool
+
+	// The timeout to use for operations executed on the Database if the context passed to the operation does not have
+	// a deadline. The default value is nil, which means that the timeout of the client used to configure the
+	// Database will be used. A value of 0 means that there is no timeout. The precedence for bounding an operation is:
+	// a deadline on the context, then the operation's maxTime option (e.g. FindOptions.MaxTime), then the collection's
+	// DefaultTimeout, then this timeout, then the client's Timeout.
+	DefaultTimeout *time.Duration
 }
 
 // Database creates a new DatabaseOptions instance.
@@ -61,6 +79,18 @@ func (d *DatabaseOptions) SetRegistry(r *bsoncodec.Registry) *DatabaseOptions {
 	return d
 }
 
+// SetCausalConsistencyDefault sets the value for the CausalConsistencyDefault field.
+func (d *DatabaseOptions) SetCausalConsistencyDefault(b bool) *DatabaseOptions {
+	d.CausalConsistencyDefault = &b
+	return d
+}
+
+// SetDefaultTimeout sets the value for the DefaultTimeout field.
+func (d *DatabaseOptions) SetDefaultTimeout(timeout time.Duration) *DatabaseOptions {
+	d.DefaultTimeout = &timeout
+	return d
+}
+
 // MergeDatabaseOptions combines the given DatabaseOptions instances into a single DatabaseOptions in a last-one-wins
 // fashion.
 func MergeDatabaseOptions(opts ...*DatabaseOptions) *DatabaseOptions {
@@ -82,6 +112,12 @@ func MergeDatabaseOptions(opts ...*DatabaseOptions) *DatabaseOptions {
 		if opt.Registry != nil {
 			d.Registry = opt.Registry
 		}
+		if opt.CausalConsistencyDefault != nil {
+			d.CausalConsistencyDefault = opt.CausalConsistencyDefault
+		}
+		if opt.DefaultTimeout != nil {
+			d.DefaultTimeout = opt.DefaultTimeout
+		}
 	}
 
 	return d
@@ -18,6 +18,11 @@ type DistinctOptions struct {
 	// The maximum amount of time that the query can run on the server. The default value is nil, meaning that there
 	// is no time limit for query execution.
 	MaxTime *time.Duration
+
+	// The index to use for the operation. This should either be the index name as a string or the index specification
+	// as a document. This option is only valid for MongoDB versions >= 7.1. Older servers will report an error if this
+	// option is specified. The default value is nil, which means that no hint will be sent.
+	Hint interface{}
 }
 
 // Distinct creates a new DistinctOptions instance.
@@ -37,6 +42,12 @@ func (do *DistinctOptions) SetMaxTime(d time.Duration) *DistinctOptions {
 	return do
 }
 
+// SetHint sets the value for the Hint field.
+func (do *DistinctOptions) SetHint(h interface{}) *DistinctOptions {
+	do.Hint = h
+	return do
+}
+
 // MergeDistinctOptions combines the given DistinctOptions instances into a single DistinctOptions in a last-one-wins
 // fashion.
 func MergeDistinctOptions(opts ...*DistinctOptions) *DistinctOptions {
@@ -51,6 +62,9 @@ func MergeDistinctOptions(opts ...*DistinctOptions) *DistinctOptions {
 		if do.MaxTime != nil {
 			distinctOpts.MaxTime = do.MaxTime
 		}
+		if do.Hint != nil {
+			distinctOpts.Hint = do.Hint
+		}
 	}
 
 	return distinctOpts
@@ -7,14 +7,32 @@
 package options
 
 import (
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
+// These constants specify valid values for QueryType.
+const (
+	QueryTypeEquality string = "equality"
+)
+
+// RangeOptions specifies index options for a Queryable Encryption field supporting "range" or "rangePreview"
+// queries. These must match the options of the field in the encryptedFields of the collection.
+type RangeOptions struct {
+	Min       *bson.RawValue
+	Max       *bson.RawValue
+	Sparsity  int64
+	Precision *int32
+}
+
 // EncryptOptions represents options to explicitly encrypt a value.
 type EncryptOptions struct {
-	KeyID      *primitive.Binary
-	KeyAltName *string
-	Algorithm  string
+	KeyID            *primitive.Binary
+	KeyAltName       *string
+	Algorithm        string
+	QueryType        string
+	ContentionFactor *int64
+	RangeOptions     *RangeOptions
 }
 
 // Encrypt creates a new EncryptOptions instance.
@@ -34,13 +52,69 @@ func (e *EncryptOptions) SetKeyAltName(keyAltName string) *EncryptOptions {
 	return e
 }
 
-// SetAlgorithm specifies an algorithm to use for encryption. This should be AEAD_AES_256_CBC_HMAC_SHA_512-Deterministic
-// or AEAD_AES_256_CBC_HMAC_SHA_512-Random. This is required.
+// SetAlgorithm specifies an algorithm to use for encryption. This should be one of the following:
+// - AEAD_AES_256_CBC_HMAC_SHA_512-Deterministic
+// - AEAD_AES_256_CBC_HMAC_SHA_512-Random
+// - Indexed
+// - Unindexed
+// - Range (requires MongoDB 8.0+)
+// - RangePreview (MongoDB 7.0 only)
+// This is required.
 func (e *EncryptOptions) SetAlgorithm(algorithm string) *EncryptOptions {
 	e.Algorithm = algorithm
 	return e
 }
 
+// SetQueryType specifies the intended query type. It is only valid to set if the algorithm is "Indexed". This should
+// be "equality".
+func (e *EncryptOptions) SetQueryType(queryType string) *EncryptOptions {
+	e.QueryType = queryType
+	return e
+}
+
+// SetContentionFactor specifies the contention factor. It is only valid to set if the algorithm is "Indexed", "Range"
+// or "RangePreview".
+func (e *EncryptOptions) SetContentionFactor(contentionFactor int64) *EncryptOptions {
+	e.ContentionFactor = &contentionFactor
+	return e
+}
+
+// SetRangeOptions specifies the options to use for explicit encryption with the "Range" or "RangePreview" algorithm.
+// It is required if the algorithm is "Range" or "RangePreview" and must not be set for any other algorithm.
+func (e *EncryptOptions) SetRangeOptions(ro *RangeOptions) *EncryptOptions {
+	e.RangeOptions = ro
+	return e
+}
+
+// RangeIndex creates a new RangeOptions instance.
+func RangeIndex() *RangeOptions {
+	return &RangeOptions{}
+}
+
+// SetMin sets the range index minimum value. It is required if the field is a Double or Decimal128.
+func (ro *RangeOptions) SetMin(min bson.RawValue) *RangeOptions {
+	ro.Min = &min
+	return ro
+}
+
+// SetMax sets the range index maximum value. It is required if the field is a Double or Decimal128.
+func (ro *RangeOptions) SetMax(max bson.RawValue) *RangeOptions {
+	ro.Max = &max
+	return ro
+}
+
+// SetSparsity sets the range index sparsity.
+func (ro *RangeOptions) SetSparsity(sparsity int64) *RangeOptions {
+	ro.Sparsity = sparsity
+	return ro
+}
+
+// SetPrecision sets the range index precision. It may only be set if the field is a Double or Decimal128.
+func (ro *RangeOptions) SetPrecision(precision int32) *RangeOptions {
+	ro.Precision = &precision
+	return ro
+}
+
 // MergeEncryptOptions combines the argued EncryptOptions in a last-one wins fashion.
 func MergeEncryptOptions(opts ...*EncryptOptions) *EncryptOptions {
 	eo := Encrypt()
@@ -58,6 +132,15 @@ func MergeEncryptOptions(opts ...*EncryptOptions) *EncryptOptions {
 		if opt.Algorithm != "" {
 			eo.Algorithm = opt.Algorithm
 		}
+		if opt.QueryType != "" {
+			eo.QueryType = opt.QueryType
+		}
+		if opt.ContentionFactor != nil {
+			eo.ContentionFactor = opt.ContentionFactor
+		}
+		if opt.RangeOptions != nil {
+			eo.RangeOptions = opt.RangeOptions
+		}
 	}
 
 	return eo
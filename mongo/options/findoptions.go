@@ -7,7 +7,12 @@
 package options
 
 import (
+	"errors"
+	"fmt"
 	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/readconcern"
 )
 
 // FindOptions represents options that can be used to configure a Find operation.
@@ -25,6 +30,15 @@ type FindOptions struct {
 	// The maximum number of documents to be included in each batch returned by the server.
 	BatchSize *int32
 
+	// A function that is called with each document returned by the server during cursor iteration. Documents for
+	// which the function returns false are skipped by the cursor's Next, TryNext, and All methods. Filtering happens
+	// entirely on the client: every document matching the Filter parameter is still fetched from the server and no
+	// index is used to evaluate the function, so the Filter parameter should be as selective as possible. The Limit and
+	// Skip options are applied by the server before documents are passed to the function. The document passed to the
+	// function is only valid for the duration of the call. The default value is nil, which means no documents are
+	// skipped.
+	ClientSideFilter func(bson.Raw) bool
+
 	// Specifies a collation to use for string comparisons during the operation. This option is only valid for MongoDB
 	// versions >= 3.4. For previous server versions, the driver will return an error if this option is used. The
 	// default value is nil, which means the default collation of the collection will be used.
@@ -64,6 +78,12 @@ type FindOptions struct {
 	// there is no minimum value.
 	Min interface{}
 
+	// Specifies that documents should be returned in natural order. A value of 1 returns documents in forward natural
+	// order and a value of -1 returns them in reverse natural order. Any other value will cause the operation to
+	// return an error. This option is sent as {$natural: <direction>} in the sort document and cannot be combined with
+	// the Sort option. The default value is nil, which means that no natural sort will be applied.
+	NaturalSort *int
+
 	// If true, the cursor created by the operation will not timeout after a period of inactivity. The default value
 	// is false.
 	NoCursorTimeout *bool
@@ -78,6 +98,18 @@ type FindOptions struct {
 	// is nil, which means all fields will be included.
 	Projection interface{}
 
+	// If true, the driver will issue the getMore for the next batch of results in a background goroutine while the
+	// current batch is being iterated, so the next batch is usually available as soon as the current one is exhausted.
+	// This requires memory for up to two batches at a time. The option is ignored if the operation is run with an
+	// explicit session. The default value is false.
+	ReadAhead *bool
+
+	// Specifies the read concern for this operation, overriding the read concern of the collection. This option cannot
+	// be used for operations run in a transaction, because the read concern of a transaction is set when the
+	// transaction is started. The "snapshot" level is only valid outside of a transaction for MongoDB versions >= 5.0.
+	// The default value is nil, which means the read concern of the collection will be used.
+	ReadConcern *readconcern.ReadConcern
+
 	// If true, the documents returned by the operation will only contain fields corresponding to the index used. The
 	// default value is false.
 	ReturnKey *bool
@@ -122,6 +154,12 @@ func (f *FindOptions) SetBatchSize(i int32) *FindOptions {
 	return f
 }
 
+// SetClientSideFilter sets the value for the ClientSideFilter field.
+func (f *FindOptions) SetClientSideFilter(filter func(bson.Raw) bool) *FindOptions {
+	f.ClientSideFilter = filter
+	return f
+}
+
 // SetCollation sets the value for the Collation field.
 func (f *FindOptions) SetCollation(collation *Collation) *FindOptions {
 	f.Collation = collation
@@ -176,6 +214,12 @@ func (f *FindOptions) SetMin(min interface{}) *FindOptions {
 	return f
 }
 
+// SetNaturalSort sets the value for the NaturalSort field.
+func (f *FindOptions) SetNaturalSort(direction int) *FindOptions {
+	f.NaturalSort = &direction
+	return f
+}
+
 // SetNoCursorTimeout sets the value for the NoCursorTimeout field.
 func (f *FindOptions) SetNoCursorTimeout(b bool) *FindOptions {
 	f.NoCursorTimeout = &b
@@ -196,6 +240,18 @@ func (f *FindOptions) SetProjection(projection interface{}) *FindOptions {
 	return f
 }
 
+// SetReadAhead sets the value for the ReadAhead field.
+func (f *FindOptions) SetReadAhead(b bool) *FindOptions {
+	f.ReadAhead = &b
+	return f
+}
+
+// SetReadConcern sets the value for the ReadConcern field.
+func (f *FindOptions) SetReadConcern(rc *readconcern.ReadConcern) *FindOptions {
+	f.ReadConcern = rc
+	return f
+}
+
 // SetReturnKey sets the value for the ReturnKey field.
 func (f *FindOptions) SetReturnKey(b bool) *FindOptions {
 	f.ReturnKey = &b
@@ -244,6 +300,9 @@ func MergeFindOptions(opts ...*FindOptions) *FindOptions {
 		if opt.BatchSize != nil {
 			fo.BatchSize = opt.BatchSize
 		}
+		if opt.ClientSideFilter != nil {
+			fo.ClientSideFilter = opt.ClientSideFilter
+		}
 		if opt.Collation != nil {
 			fo.Collation = opt.Collation
 		}
@@ -271,6 +330,9 @@ func MergeFindOptions(opts ...*FindOptions) *FindOptions {
 		if opt.Min != nil {
 			fo.Min = opt.Min
 		}
+		if opt.NaturalSort != nil {
+			fo.NaturalSort = opt.NaturalSort
+		}
 		if opt.NoCursorTimeout != nil {
 			fo.NoCursorTimeout = opt.NoCursorTimeout
 		}
@@ -280,6 +342,12 @@ func MergeFindOptions(opts ...*FindOptions) *FindOptions {
 		if opt.Projection != nil {
 			fo.Projection = opt.Projection
 		}
+		if opt.ReadAhead != nil {
+			fo.ReadAhead = opt.ReadAhead
+		}
+		if opt.ReadConcern != nil {
+			fo.ReadConcern = opt.ReadConcern
+		}
 		if opt.ReturnKey != nil {
 			fo.ReturnKey = opt.ReturnKey
 		}
@@ -300,6 +368,88 @@ func MergeFindOptions(opts ...*FindOptions) *FindOptions {
 	return fo
 }
 
+// FindOptionsFromModifiers translates a legacy query modifiers document (e.g. {$orderby: {x: 1}, $comment: "foo"}),
+// as accepted by the removed Modifiers option, into a FindOptions. This is intended to ease the migration of queries
+// written for older drivers. The supported modifiers and the fields they are translated to are:
+//
+// 1. $comment: Comment. The value must be a string.
+//
+// 2. $hint: Hint.
+//
+// 3. $max: Max.
+//
+// 4. $maxTimeMS: MaxTime. The value must be a number of milliseconds.
+//
+// 5. $min: Min.
+//
+// 6. $orderby: Sort.
+//
+// 7. $returnKey: ReturnKey.
+//
+// 8. $showDiskLoc: ShowRecordID.
+//
+// 9. $snapshot: Snapshot.
+//
+// An error is returned if the document contains any other key or a value of the wrong type. In particular, $query is
+// rejected because the query filter must be passed as the filter parameter of the Find method, and $explain and
+// $maxScan are rejected because they are no longer supported by the server.
+func FindOptionsFromModifiers(modifiers bson.D) (*FindOptions, error) {
+	fo := Find()
+	for _, elem := range modifiers {
+		switch elem.Key {
+		case "$comment":
+			comment, ok := elem.Value.(string)
+			if !ok {
+				return nil, fmt.Errorf("$comment modifier must be a string, got %T", elem.Value)
+			}
+			fo.SetComment(comment)
+		case "$hint":
+			fo.SetHint(elem.Value)
+		case "$max":
+			fo.SetMax(elem.Value)
+		case "$maxTimeMS":
+			var ms int64
+			switch val := elem.Value.(type) {
+			case int:
+				ms = int64(val)
+			case int32:
+				ms = int64(val)
+			case int64:
+				ms = val
+			case float64:
+				ms = int64(val)
+			default:
+				return nil, fmt.Errorf("$maxTimeMS modifier must be a number, got %T", elem.Value)
+			}
+			fo.SetMaxTime(time.Duration(ms) * time.Millisecond)
+		case "$min":
+			fo.SetMin(elem.Value)
+		case "$orderby":
+			fo.SetSort(elem.Value)
+		case "$returnKey", "$showDiskLoc", "$snapshot":
+			b, ok := elem.Value.(bool)
+			if !ok {
+				return nil, fmt.Errorf("%s modifier must be a boolean, got %T", elem.Key, elem.Value)
+			}
+			switch elem.Key {
+			case "$returnKey":
+				fo.SetReturnKey(b)
+			case "$showDiskLoc":
+				fo.SetShowRecordID(b)
+			default:
+				fo.Snapshot = &b
+			}
+		case "$query":
+			return nil, errors.New("$query modifier is not supported: pass the query as the filter parameter instead")
+		case "$explain", "$maxScan":
+			return nil, fmt.Errorf("%s modifier is not supported by the server", elem.Key)
+		default:
+			return nil, fmt.Errorf("unsupported query modifier %q", elem.Key)
+		}
+	}
+	return fo, nil
+}
+
 // FindOneOptions represents options that can be used to configure a FindOne operation.
 type FindOneOptions struct {
 	// If true, an operation on a sharded cluster can return partial results if some shards are down rather than
@@ -0,0 +1,57 @@
+package options
+
+import (
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/internal/testutil/assert"
+)
+
+func TestFindOptionsFromModifiers(t *testing.T) {
+	t.Run("translates modifiers", func(t *testing.T) {
+		modifiers := bson.D{
+			{"$comment", "legacy query"},
+			{"$hint", "x_1"},
+			{"$max", bson.D{{"x", 10}}},
+			{"$maxTimeMS", int32(500)},
+			{"$min", bson.D{{"x", 1}}},
+			{"$orderby", bson.D{{"x", -1}}},
+			{"$returnKey", true},
+			{"$showDiskLoc", true},
+			{"$snapshot", false},
+		}
+		fo, err := FindOptionsFromModifiers(modifiers)
+		assert.Nil(t, err, "FindOptionsFromModifiers error: %v", err)
+
+		assert.Equal(t, "legacy query", *fo.Comment, "expected comment %v, got %v", "legacy query", *fo.Comment)
+		assert.Equal(t, "x_1", fo.Hint, "expected hint %v, got %v", "x_1", fo.Hint)
+		assert.Equal(t, bson.D{{"x", 10}}, fo.Max, "expected max %v, got %v", bson.D{{"x", 10}}, fo.Max)
+		assert.Equal(t, 500*time.Millisecond, *fo.MaxTime, "expected max time %v, got %v", 500*time.Millisecond, *fo.MaxTime)
+		assert.Equal(t, bson.D{{"x", 1}}, fo.Min, "expected min %v, got %v", bson.D{{"x", 1}}, fo.Min)
+		assert.Equal(t, bson.D{{"x", -1}}, fo.Sort, "expected sort %v, got %v", bson.D{{"x", -1}}, fo.Sort)
+		assert.True(t, *fo.ReturnKey, "expected ReturnKey to be true")
+		assert.True(t, *fo.ShowRecordID, "expected ShowRecordID to be true")
+		assert.False(t, *fo.Snapshot, "expected Snapshot to be false")
+	})
+	t.Run("errors", func(t *testing.T) {
+		testCases := []struct {
+			name      string
+			modifiers bson.D
+		}{
+			{"query", bson.D{{"$query", bson.D{{"x", 1}}}}},
+			{"explain", bson.D{{"$explain", true}}},
+			{"maxScan", bson.D{{"$maxScan", 10}}},
+			{"unknown", bson.D{{"$foo", 1}}},
+			{"comment type", bson.D{{"$comment", 1}}},
+			{"maxTimeMS type", bson.D{{"$maxTimeMS", "500"}}},
+			{"returnKey type", bson.D{{"$returnKey", 1}}},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := FindOptionsFromModifiers(tc.modifiers)
+				assert.NotNil(t, err, "expected error, got nil")
+			})
+		}
+	})
+}
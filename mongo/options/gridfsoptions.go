@@ -60,7 +60,8 @@ func (b *BucketOptions) SetName(name string) *BucketOptions {
 	return b
 }
 
-// SetChunkSizeBytes sets the value for the ChunkSize field.
+// SetChunkSizeBytes sets the value for the ChunkSize field. The size must be positive and no larger than
+// gridfs.MaxChunkSize, otherwise creating the bucket will return an error.
 func (b *BucketOptions) SetChunkSizeBytes(i int32) *BucketOptions {
 	b.ChunkSizeBytes = &i
 	return b
@@ -131,7 +132,8 @@ func GridFSUpload() *UploadOptions {
 	return &UploadOptions{Registry: bson.DefaultRegistry}
 }
 
-// SetChunkSizeBytes sets the value for the ChunkSize field.
+// SetChunkSizeBytes sets the value for the ChunkSize field. If set, it overrides the bucket's chunk size for this
+// upload. The size must be positive and no larger than gridfs.MaxChunkSize, otherwise the upload will return an error.
 func (u *UploadOptions) SetChunkSizeBytes(i int32) *UploadOptions {
 	u.ChunkSizeBytes = &i
 	return u
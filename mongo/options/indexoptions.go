@@ -27,6 +27,11 @@ type CreateIndexesOptions struct {
 	// used. See dochub.mongodb.org/core/index-commit-quorum for more information.
 	CommitQuorum interface{}
 
+	// A string or document that will be included in server logs, profiling logs, and currentOp queries to help trace
+	// the operation. This option is only available on MongoDB versions >= 4.4. The default is nil, which means that
+	// no comment will be sent.
+	Comment interface{}
+
 	// The maximum amount of time that the query can run on the server. The default value is nil, meaning that there
 	// is no time limit for query execution.
 	MaxTime *time.Duration
@@ -43,6 +48,12 @@ func (c *CreateIndexesOptions) SetMaxTime(d time.Duration) *CreateIndexesOptions
 	return c
 }
 
+// SetComment sets the value for the Comment field.
+func (c *CreateIndexesOptions) SetComment(comment interface{}) *CreateIndexesOptions {
+	c.Comment = comment
+	return c
+}
+
 // SetCommitQuorumInt sets the value for the CommitQuorum field as an int32.
 func (c *CreateIndexesOptions) SetCommitQuorumInt(quorum int32) *CreateIndexesOptions {
 	c.CommitQuorum = quorum
@@ -81,6 +92,9 @@ func MergeCreateIndexesOptions(opts ...*CreateIndexesOptions) *CreateIndexesOpti
 		if opt.CommitQuorum != nil {
 			c.CommitQuorum = opt.CommitQuorum
 		}
+		if opt.Comment != nil {
+			c.Comment = opt.Comment
+		}
 	}
 
 	return c
@@ -89,6 +103,11 @@ func MergeCreateIndexesOptions(opts ...*CreateIndexesOptions) *CreateIndexesOpti
 // DropIndexesOptions represents options that can be used to configure IndexView.DropOne and IndexView.DropAll
 // operations.
 type DropIndexesOptions struct {
+	// A string or document that will be included in server logs, profiling logs, and currentOp queries to help trace
+	// the operation. This option is only available on MongoDB versions >= 4.4. The default is nil, which means that
+	// no comment will be sent.
+	Comment interface{}
+
 	// The maximum amount of time that the query can run on the server. The default value is nil, meaning that there
 	// is no time limit for query execution.
 	MaxTime *time.Duration
@@ -99,6 +118,12 @@ func DropIndexes() *DropIndexesOptions {
 	return &DropIndexesOptions{}
 }
 
+// SetComment sets the value for the Comment field.
+func (d *DropIndexesOptions) SetComment(comment interface{}) *DropIndexesOptions {
+	d.Comment = comment
+	return d
+}
+
 // SetMaxTime sets the value for the MaxTime field.
 func (d *DropIndexesOptions) SetMaxTime(duration time.Duration) *DropIndexesOptions {
 	d.MaxTime = &duration
@@ -116,6 +141,9 @@ func MergeDropIndexesOptions(opts ...*DropIndexesOptions) *DropIndexesOptions {
 		if opt.MaxTime != nil {
 			c.MaxTime = opt.MaxTime
 		}
+		if opt.Comment != nil {
+			c.Comment = opt.Comment
+		}
 	}
 
 	return c
@@ -126,6 +154,11 @@ type ListIndexesOptions struct {
 	// The maximum number of documents to be included in each batch returned by the server.
 	BatchSize *int32
 
+	// A string that will be included in server logs, profiling logs, and currentOp queries to help trace the operation.
+	// This option is only valid for MongoDB versions >= 4.4. The default value is nil, which means that no comment will
+	// be included in the logs.
+	Comment *string
+
 	// The maximum amount of time that the query can run on the server. The default value is nil, meaning that there
 	// is no time limit for query execution.
 	MaxTime *time.Duration
@@ -142,6 +175,12 @@ func (l *ListIndexesOptions) SetBatchSize(i int32) *ListIndexesOptions {
 	return l
 }
 
+// SetComment sets the value for the Comment field.
+func (l *ListIndexesOptions) SetComment(comment string) *ListIndexesOptions {
+	l.Comment = &comment
+	return l
+}
+
 // SetMaxTime sets the value for the MaxTime field.
 func (l *ListIndexesOptions) SetMaxTime(d time.Duration) *ListIndexesOptions {
 	l.MaxTime = &d
@@ -159,6 +198,9 @@ func MergeListIndexesOptions(opts ...*ListIndexesOptions) *ListIndexesOptions {
 		if opt.BatchSize != nil {
 			c.BatchSize = opt.BatchSize
 		}
+		if opt.Comment != nil {
+			c.Comment = opt.Comment
+		}
 		if opt.MaxTime != nil {
 			c.MaxTime = opt.MaxTime
 		}
@@ -242,11 +284,19 @@ type IndexOptions struct {
 	BucketSize *int32
 
 	// A document that defines which collection documents the index should reference. This option is only valid for
-	// MongoDB versions >= 3.2 and is ignored for previous server versions.
+	// MongoDB versions >= 3.2 and is ignored for previous server versions. The expression can only contain equality
+	// matches, $exists: true, $gt, $gte, $lt, $lte, $type, $in, $and, and $or. The driver will return an error naming
+	// the offending operator if any other operator is used. $in and $or are only valid for MongoDB versions >= 6.0, and
+	// the server may reject other combinations, such as nested $and and $or, depending on its version.
 	PartialFilterExpression interface{}
 
 	// The collation to use for string comparisons for the index. This option is only valid for MongoDB versions >= 3.4.
-	// For previous server versions, the driver will return an error if this option is used.
+	// For previous server versions, the driver will return an error if this option is used. The collation must specify
+	// a Locale, and Strength must be between 1 and 5 if it is set; the driver will return an error before sending the
+	// command otherwise.
+	//
+	// A collated index can only be used for string comparisons by queries, sorts, and aggregations that specify the
+	// same collation. Queries without a collation, or with a different one, cannot use the index to compare strings.
 	Collation *Collation
 
 	// A document that defines the wildcard projection for the index.
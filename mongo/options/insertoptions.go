@@ -13,6 +13,12 @@ type InsertOneOptions struct {
 	// false. See https://docs.mongodb.com/manual/core/schema-validation/ for more information about document
 	// validation.
 	BypassDocumentValidation *bool
+
+	// If true, the server will not replace empty Timestamp values (i.e. Timestamp(0, 0)) in the top-level fields of
+	// inserted documents with the current cluster time. This is useful for tools such as oplog restorers that must
+	// preserve literal zero timestamps. This option is only valid for MongoDB versions >= 8.0 and is ignored for
+	// previous server versions. The default value is false.
+	BypassEmptyTimestampReplacement *bool
 }
 
 // InsertOne creates a new InsertOneOptions instance.
@@ -26,6 +32,12 @@ func (ioo *InsertOneOptions) SetBypassDocumentValidation(b bool) *InsertOneOptio
 	return ioo
 }
 
+// SetBypassEmptyTimestampReplacement sets the value for the BypassEmptyTimestampReplacement field.
+func (ioo *InsertOneOptions) SetBypassEmptyTimestampReplacement(b bool) *InsertOneOptions {
+	ioo.BypassEmptyTimestampReplacement = &b
+	return ioo
+}
+
 // MergeInsertOneOptions combines the given InsertOneOptions instances into a single InsertOneOptions in a last-one-wins
 // fashion.
 func MergeInsertOneOptions(opts ...*InsertOneOptions) *InsertOneOptions {
@@ -37,6 +49,9 @@ func MergeInsertOneOptions(opts ...*InsertOneOptions) *InsertOneOptions {
 		if ioo.BypassDocumentValidation != nil {
 			ioOpts.BypassDocumentValidation = ioo.BypassDocumentValidation
 		}
+		if ioo.BypassEmptyTimestampReplacement != nil {
+			ioOpts.BypassEmptyTimestampReplacement = ioo.BypassEmptyTimestampReplacement
+		}
 	}
 
 	return ioOpts
@@ -50,7 +65,16 @@ type InsertManyOptions struct {
 	// validation.
 	BypassDocumentValidation *bool
 
-	// If true, no writes will be executed after one fails. The default value is true.
+	// If true, the server will not replace empty Timestamp values (i.e. Timestamp(0, 0)) in the top-level fields of
+	// inserted documents with the current cluster time. This is useful for tools such as oplog restorers that must
+	// preserve literal zero timestamps. This option is only valid for MongoDB versions >= 8.0 and is ignored for
+	// previous server versions. The default value is false.
+	BypassEmptyTimestampReplacement *bool
+
+	// If true, the documents will be inserted in the order they were provided and no writes will be executed after one
+	// fails, so the returned error will contain at most one write error. If false, the server will attempt to insert
+	// every document regardless of earlier failures and may insert them in any order, and the returned error will
+	// contain a write error for each document that could not be inserted. The default value is true.
 	Ordered *bool
 }
 
@@ -67,12 +91,23 @@ func (imo *InsertManyOptions) SetBypassDocumentValidation(b bool) *InsertManyOpt
 	return imo
 }
 
+// SetBypassEmptyTimestampReplacement sets the value for the BypassEmptyTimestampReplacement field.
+func (imo *InsertManyOptions) SetBypassEmptyTimestampReplacement(b bool) *InsertManyOptions {
+	imo.BypassEmptyTimestampReplacement = &b
+	return imo
+}
+
 // SetOrdered sets the value for the Ordered field.
 func (imo *InsertManyOptions) SetOrdered(b bool) *InsertManyOptions {
 	imo.Ordered = &b
 	return imo
 }
 
+// SetUnordered sets the Ordered field to false. This is equivalent to calling SetOrdered(false).
+func (imo *InsertManyOptions) SetUnordered() *InsertManyOptions {
+	return imo.SetOrdered(false)
+}
+
 // MergeInsertManyOptions combines the givent InsertManyOptions instances into a single InsertManyOptions in a last one
 // wins fashion.
 func MergeInsertManyOptions(opts ...*InsertManyOptions) *InsertManyOptions {
@@ -84,6 +119,9 @@ func MergeInsertManyOptions(opts ...*InsertManyOptions) *InsertManyOptions {
 		if imo.BypassDocumentValidation != nil {
 			imOpts.BypassDocumentValidation = imo.BypassDocumentValidation
 		}
+		if imo.BypassEmptyTimestampReplacement != nil {
+			imOpts.BypassEmptyTimestampReplacement = imo.BypassEmptyTimestampReplacement
+		}
 		if imo.Ordered != nil {
 			imOpts.Ordered = imo.Ordered
 		}
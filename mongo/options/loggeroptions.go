@@ -0,0 +1,91 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package options
+
+// LogLevel is the verbosity level of a log message.
+type LogLevel int
+
+// These constants are the possible verbosity levels. A component configured with a given level will emit messages at
+// that level and all less verbose levels.
+const (
+	// LogLevelOff disables logging for a component. This is the default level for all components.
+	LogLevelOff LogLevel = 0
+	// LogLevelInfo enables logging of failures, such as failed commands, failed heartbeats, failed connection
+	// check-outs, and failed server selection attempts.
+	LogLevelInfo LogLevel = 1
+	// LogLevelDebug enables logging of all messages for a component.
+	LogLevelDebug LogLevel = 2
+)
+
+// LogComponent is a component of the driver that can emit log messages.
+type LogComponent string
+
+// These constants are the components that can be configured using LoggerOptions.SetComponentLevel.
+const (
+	// LogComponentAll applies a level to every component that does not have an explicit level set.
+	LogComponentAll LogComponent = "all"
+	// LogComponentCommand logs commands sent to the server and their results.
+	LogComponentCommand LogComponent = "command"
+	// LogComponentTopology logs server and topology description changes and server heartbeats.
+	LogComponentTopology LogComponent = "topology"
+	// LogComponentServerSelection logs the outcome of server selection.
+	LogComponentServerSelection LogComponent = "serverSelection"
+	// LogComponentConnection logs connection pool and connection lifecycle events.
+	LogComponentConnection LogComponent = "connection"
+)
+
+// LogSink is the interface used to emit log messages. If no sink is configured, messages are written to standard
+// error.
+type LogSink interface {
+	// Info is called for messages that do not describe a failure. The level will be LogLevelDebug or LogLevelInfo.
+	// The keysAndValues parameter is a list of alternating keys and values describing the message.
+	Info(level int, message string, keysAndValues ...interface{})
+
+	// Error is called for messages that describe a failure.
+	Error(err error, message string, keysAndValues ...interface{})
+}
+
+// LoggerOptions represents options to configure the driver's logging.
+type LoggerOptions struct {
+	// ComponentLevels maps components to the level of messages that they should emit. Components that are not
+	// present use the level set for LogComponentAll, or LogLevelOff if that is not set either.
+	ComponentLevels map[LogComponent]LogLevel
+
+	// Sink is the LogSink that log messages are written to. The default is a sink that writes to standard error.
+	Sink LogSink
+}
+
+// Logger creates a new LoggerOptions instance.
+func Logger() *LoggerOptions {
+	return &LoggerOptions{
+		ComponentLevels: map[LogComponent]LogLevel{},
+	}
+}
+
+// SetComponentLevel sets the level of messages emitted by the given component. Use LogComponentAll to set the level
+// for every component that does not have an explicit level.
+func (lo *LoggerOptions) SetComponentLevel(component LogComponent, level LogLevel) *LoggerOptions {
+	if lo.ComponentLevels == nil {
+		lo.ComponentLevels = map[LogComponent]LogLevel{}
+	}
+	lo.ComponentLevels[component] = level
+	return lo
+}
+
+// SetSink sets the LogSink that log messages are written to.
+func (lo *LoggerOptions) SetSink(sink LogSink) *LoggerOptions {
+	lo.Sink = sink
+	return lo
+}
+
+// Level returns the level of messages emitted by the given component.
+func (lo *LoggerOptions) Level(component LogComponent) LogLevel {
+	if level, ok := lo.ComponentLevels[component]; ok {
+		return level
+	}
+	return lo.ComponentLevels[LogComponentAll]
+}
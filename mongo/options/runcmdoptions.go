@@ -13,6 +13,11 @@ type RunCmdOptions struct {
 	// The read preference to use for the operation. The default value is nil, which means that the primary read
 	// preference will be used.
 	ReadPreference *readpref.ReadPref
+
+	// If true, the command will be treated as an idempotent read and retried once on transient errors, subject to the
+	// Client's RetryReads setting. The command is re-run on a newly selected server. This should only be set for
+	// commands that are known to be safe to run more than once (e.g. dbStats). The default value is false.
+	Retryable *bool
 }
 
 // RunCmd creates a new RunCmdOptions instance.
@@ -26,6 +31,12 @@ func (rc *RunCmdOptions) SetReadPreference(rp *readpref.ReadPref) *RunCmdOptions
 	return rc
 }
 
+// SetRetryable sets the value for the Retryable field.
+func (rc *RunCmdOptions) SetRetryable(b bool) *RunCmdOptions {
+	rc.Retryable = &b
+	return rc
+}
+
 // MergeRunCmdOptions combines the given RunCmdOptions instances into one *RunCmdOptions in a last-one-wins fashion.
 func MergeRunCmdOptions(opts ...*RunCmdOptions) *RunCmdOptions {
 	rc := RunCmd()
@@ -36,6 +47,9 @@ func MergeRunCmdOptions(opts ...*RunCmdOptions) *RunCmdOptions {
 		if opt.ReadPreference != nil {
 			rc.ReadPreference = opt.ReadPreference
 		}
+		if opt.Retryable != nil {
+			rc.Retryable = opt.Retryable
+		}
 	}
 
 	return rc
@@ -0,0 +1,613 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+// Package pipeline provides helpers for building aggregation pipeline stages that can be used in a mongo.Pipeline.
+package pipeline
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// OutToDatabase returns a $out stage that writes the results of an aggregation to the collection coll in the database
+// db. The stage must be the last stage in the pipeline. Writing to a collection in a different database than the one
+// the aggregation runs against requires MongoDB server version 4.4 or higher.
+//
+// Example usage:
+//
+//		mongo.Pipeline{
+//			{{"$match", bson.D{{"status", "A"}}}},
+//			pipeline.OutToDatabase("reporting", "active"),
+//		}
+//
+func OutToDatabase(db, coll string) bson.D {
+	return bson.D{{"$out", bson.D{{"db", db}, {"coll", coll}}}}
+}
+
+// WindowOperator describes a window operator used to compute an output field of a $setWindowFields stage.
+type WindowOperator struct {
+	// Operator is the name of the window operator, including the leading '$' (e.g. "$sum" or "$rank").
+	Operator string
+
+	// Argument is the argument passed to the operator (e.g. "$quantity" for "$sum"). Operators that do not take an
+	// argument, such as "$rank", should use an empty document.
+	Argument interface{}
+
+	// Documents specifies a window whose lower and upper bounds are positions relative to the current document. Each
+	// bound may be "unbounded", "current", or an integer. Documents and Range cannot both be set.
+	Documents []interface{}
+
+	// Range specifies a window whose lower and upper bounds are values relative to the sortBy field of the current
+	// document. Each bound may be "unbounded", "current", or a number. Documents and Range cannot both be set.
+	Range []interface{}
+
+	// Unit specifies the unit of the Range bounds when the sortBy field is a date (e.g. "day" or "hour"). Unit can
+	// only be set if Range is also set.
+	Unit string
+}
+
+// SetWindowFields returns a $setWindowFields stage that partitions documents by partitionBy, sorts them within each
+// partition by sortBy, and computes the fields in output using window operators. The partitionBy and sortBy parameters
+// may be nil to omit the corresponding fields of the stage. Output fields are added to the stage in lexicographic
+// order. The $setWindowFields stage requires MongoDB server version 5.0 or higher.
+//
+// An error is returned if output is empty, if an operator is malformed, or if a Documents or Range window is used
+// without a sortBy.
+//
+// Example usage:
+//
+//		stage, err := pipeline.SetWindowFields("$state", bson.D{{"orderDate", 1}}, map[string]pipeline.WindowOperator{
+//			"cumulativeQuantity": {
+//				Operator:  "$sum",
+//				Argument:  "$quantity",
+//				Documents: []interface{}{"unbounded", "current"},
+//			},
+//		})
+//
+func SetWindowFields(partitionBy, sortBy interface{}, output map[string]WindowOperator) (bson.D, error) {
+	if len(output) == 0 {
+		return nil, errors.New("$setWindowFields requires at least one output field")
+	}
+
+	names := make([]string, 0, len(output))
+	for name := range output {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	outputDoc := make(bson.D, 0, len(names))
+	for _, name := range names {
+		op := output[name]
+		if !strings.HasPrefix(op.Operator, "$") {
+			return nil, fmt.Errorf("output field %q: operator %q must start with '$'", name, op.Operator)
+		}
+
+		window, err := op.window()
+		if err != nil {
+			return nil, fmt.Errorf("output field %q: %v", name, err)
+		}
+		if window != nil && sortBy == nil {
+			return nil, fmt.Errorf("output field %q: a sortBy is required when a documents or range window is used", name)
+		}
+
+		arg := op.Argument
+		if arg == nil {
+			arg = bson.D{}
+		}
+		field := bson.D{{op.Operator, arg}}
+		if window != nil {
+			field = append(field, bson.E{"window", window})
+		}
+		outputDoc = append(outputDoc, bson.E{name, field})
+	}
+
+	var stage bson.D
+	if partitionBy != nil {
+		stage = append(stage, bson.E{"partitionBy", partitionBy})
+	}
+	if sortBy != nil {
+		stage = append(stage, bson.E{"sortBy", sortBy})
+	}
+	stage = append(stage, bson.E{"output", outputDoc})
+
+	return bson.D{{"$setWindowFields", stage}}, nil
+}
+
+// window returns the window document for the operator, or nil if no bounds are set.
+func (wo WindowOperator) window() (bson.D, error) {
+	switch {
+	case wo.Documents != nil && wo.Range != nil:
+		return nil, errors.New("documents and range windows cannot both be set")
+	case wo.Unit != "" && wo.Range == nil:
+		return nil, errors.New("unit can only be set for range windows")
+	case wo.Documents != nil:
+		if len(wo.Documents) != 2 {
+			return nil, fmt.Errorf("documents window must have exactly 2 bounds, got %d", len(wo.Documents))
+		}
+		return bson.D{{"documents", bson.A(wo.Documents)}}, nil
+	case wo.Range != nil:
+		if len(wo.Range) != 2 {
+			return nil, fmt.Errorf("range window must have exactly 2 bounds, got %d", len(wo.Range))
+		}
+		window := bson.D{{"range", bson.A(wo.Range)}}
+		if wo.Unit != "" {
+			window = append(window, bson.E{"unit", wo.Unit})
+		}
+		return window, nil
+	}
+	return nil, nil
+}
+
+// GeoNearOptions contains the optional fields of a $geoNear stage. Fields with zero values are omitted from the stage.
+type GeoNearOptions struct {
+	// Spherical specifies whether distances are calculated using spherical geometry.
+	Spherical bool
+
+	// MaxDistance is the maximum distance from the center point for a document to be included in the results. The
+	// distance is in meters if near is a GeoJSON point and in radians if near is a legacy coordinate pair.
+	MaxDistance float64
+
+	// MinDistance is the minimum distance from the center point for a document to be included in the results. It uses
+	// the same units as MaxDistance.
+	MinDistance float64
+
+	// Query limits the results to the documents that match the query. The query cannot contain a $near predicate.
+	Query interface{}
+
+	// DistanceMultiplier is a factor that all distances returned by the stage are multiplied by (e.g. to convert
+	// radians to kilometers).
+	DistanceMultiplier float64
+
+	// IncludeLocs is the output field that contains the location used to calculate the distance. It is useful when the
+	// location field contains multiple locations.
+	IncludeLocs string
+
+	// Key is the geospatial indexed field to use when calculating the distance. It is required if the collection has
+	// more than one 2d or 2dsphere index.
+	Key string
+}
+
+// GeoNear returns a $geoNear stage that outputs documents in order of nearest to farthest from near, storing the
+// calculated distance in distanceField. The near parameter must be either a GeoJSON point (e.g.
+// bson.D{{"type", "Point"}, {"coordinates", bson.A{-73.99, 40.73}}}) or a legacy coordinate pair (e.g.
+// bson.A{-73.99, 40.73}).
+//
+// An error is returned if near is not a valid point, if distanceField is empty or starts with '$', or if the
+// distances in opts are negative or inconsistent. The stage must be the first stage in the pipeline, which can be
+// checked with ValidateGeoNearPosition. The server also requires a 2d or 2dsphere index on the queried field, which
+// cannot be checked client-side; if no such index exists, the aggregation will fail.
+//
+// Example usage:
+//
+//		stage, err := pipeline.GeoNear(bson.D{{"type", "Point"}, {"coordinates", bson.A{-73.99, 40.73}}}, "dist",
+//			pipeline.GeoNearOptions{Spherical: true, MaxDistance: 2000})
+//
+func GeoNear(near interface{}, distanceField string, opts GeoNearOptions) (bson.D, error) {
+	if err := validateNearPoint(near); err != nil {
+		return nil, err
+	}
+	if distanceField == "" {
+		return nil, errors.New("$geoNear requires a distanceField")
+	}
+	if strings.HasPrefix(distanceField, "$") {
+		return nil, fmt.Errorf("distanceField %q must not start with '$'", distanceField)
+	}
+	if opts.MaxDistance < 0 || opts.MinDistance < 0 {
+		return nil, errors.New("$geoNear distances must not be negative")
+	}
+	if opts.MaxDistance != 0 && opts.MinDistance > opts.MaxDistance {
+		return nil, fmt.Errorf("minDistance %v must not be greater than maxDistance %v", opts.MinDistance,
+			opts.MaxDistance)
+	}
+
+	stage := bson.D{{"near", near}, {"distanceField", distanceField}}
+	if opts.Spherical {
+		stage = append(stage, bson.E{"spherical", true})
+	}
+	if opts.MaxDistance != 0 {
+		stage = append(stage, bson.E{"maxDistance", opts.MaxDistance})
+	}
+	if opts.MinDistance != 0 {
+		stage = append(stage, bson.E{"minDistance", opts.MinDistance})
+	}
+	if opts.Query != nil {
+		stage = append(stage, bson.E{"query", opts.Query})
+	}
+	if opts.DistanceMultiplier != 0 {
+		stage = append(stage, bson.E{"distanceMultiplier", opts.DistanceMultiplier})
+	}
+	if opts.IncludeLocs != "" {
+		stage = append(stage, bson.E{"includeLocs", opts.IncludeLocs})
+	}
+	if opts.Key != "" {
+		stage = append(stage, bson.E{"key", opts.Key})
+	}
+
+	return bson.D{{"$geoNear", stage}}, nil
+}
+
+// ValidateGeoNearPosition returns an error if stages contains a $geoNear stage anywhere other than as the first stage.
+// A mongo.Pipeline can be passed directly.
+func ValidateGeoNearPosition(stages []bson.D) error {
+	for i, stage := range stages {
+		if i > 0 && len(stage) > 0 && stage[0].Key == "$geoNear" {
+			return fmt.Errorf("$geoNear must be the first stage in the pipeline, but is stage %d", i)
+		}
+	}
+	return nil
+}
+
+// validateNearPoint returns an error if near is neither a GeoJSON point nor a legacy coordinate pair.
+func validateNearPoint(near interface{}) error {
+	if near == nil {
+		return errors.New("$geoNear requires a near point")
+	}
+
+	t, data, err := bson.MarshalValue(near)
+	if err != nil {
+		return fmt.Errorf("unable to marshal near point: %v", err)
+	}
+	val := bson.RawValue{Type: t, Value: data}
+
+	switch t {
+	case bson.TypeArray:
+		if !isCoordinatePair(val) {
+			return errors.New("legacy coordinate pair must contain exactly 2 numbers")
+		}
+	case bson.TypeEmbeddedDocument:
+		doc := val.Document()
+		if typ, ok := doc.Lookup("type").StringValueOK(); !ok || typ != "Point" {
+			return errors.New(`GeoJSON near point must have type "Point"`)
+		}
+		if !isCoordinatePair(doc.Lookup("coordinates")) {
+			return errors.New("GeoJSON point coordinates must contain exactly 2 numbers")
+		}
+	default:
+		return fmt.Errorf("near must be a GeoJSON point or a legacy coordinate pair, got BSON type %s", t)
+	}
+	return nil
+}
+
+func isCoordinatePair(val bson.RawValue) bool {
+	arr, ok := val.ArrayOK()
+	if !ok {
+		return false
+	}
+	vals, err := arr.Values()
+	if err != nil || len(vals) != 2 {
+		return false
+	}
+	for _, v := range vals {
+		switch v.Type {
+		case bson.TypeDouble, bson.TypeInt32, bson.TypeInt64, bson.TypeDecimal128:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// UnionWith returns a $unionWith stage that combines the results of the aggregation with the documents from the
+// collection coll after they have been processed by subPipeline. If subPipeline is empty, all documents in coll are
+// included and the short form {$unionWith: coll} is returned. A mongo.Pipeline can be passed directly as subPipeline.
+//
+// An error is returned if coll is empty or if subPipeline contains a $out or $merge stage, which are not allowed in a
+// $unionWith sub-pipeline. The $unionWith stage requires MongoDB server version 4.4 or higher.
+//
+// Example usage:
+//
+//		stage, err := pipeline.UnionWith("archive", mongo.Pipeline{
+//			{{"$match", bson.D{{"year", 2020}}}},
+//		})
+//
+func UnionWith(coll string, subPipeline []bson.D) (bson.D, error) {
+	if coll == "" {
+		return nil, errors.New("$unionWith requires a collection name")
+	}
+	if len(subPipeline) == 0 {
+		return bson.D{{"$unionWith", coll}}, nil
+	}
+	for i, stage := range subPipeline {
+		if len(stage) == 0 {
+			continue
+		}
+		if key := stage[0].Key; key == "$out" || key == "$merge" {
+			return nil, fmt.Errorf("%s is not allowed in a $unionWith sub-pipeline, but is stage %d", key, i)
+		}
+	}
+
+	return bson.D{{"$unionWith", bson.D{{"coll", coll}, {"pipeline", subPipeline}}}}, nil
+}
+
+// Sample returns a $sample stage that randomly selects size documents from its input. The selection is made by the
+// server, so the documents returned are not deterministic across runs.
+//
+// An error is returned if size is not positive.
+//
+// Example usage:
+//
+//		stage, err := pipeline.Sample(100)
+//
+func Sample(size int) (bson.D, error) {
+	if size <= 0 {
+		return nil, fmt.Errorf("$sample size must be positive, got %d", size)
+	}
+	return bson.D{{"$sample", bson.D{{"size", size}}}}, nil
+}
+
+// DensifyOptions contains the fields of a $densify stage other than the field being densified.
+type DensifyOptions struct {
+	// PartitionByFields is the set of fields used to group documents. If set, the field is densified separately within
+	// each partition.
+	PartitionByFields []string
+
+	// Step is the amount to increment the field by for each generated document. It must be positive, and must be a
+	// whole number if Unit is set.
+	Step float64
+
+	// Unit is the time unit of Step when the field is a date (e.g. "hour" or "day"). It must be empty if the field is
+	// numeric.
+	Unit string
+
+	// Bounds is the range over which the field is densified. It must be "full", "partition", or a pair of lower and
+	// upper bounds (e.g. []interface{}{0, 100}).
+	Bounds interface{}
+}
+
+// Densify returns a $densify stage that creates new documents to fill in missing values of field within the range
+// described by opts. The $densify stage requires MongoDB server version 5.1 or higher.
+//
+// An error is returned if field is empty or starts with '$', if Step is not positive, if Step is not a whole number
+// when Unit is set, or if Bounds is missing or malformed.
+//
+// Example usage:
+//
+//		stage, err := pipeline.Densify("timestamp", pipeline.DensifyOptions{
+//			PartitionByFields: []string{"sensor"},
+//			Step:              1,
+//			Unit:              "hour",
+//			Bounds:            "partition",
+//		})
+//
+func Densify(field string, opts DensifyOptions) (bson.D, error) {
+	if field == "" {
+		return nil, errors.New("$densify requires a field")
+	}
+	if strings.HasPrefix(field, "$") {
+		return nil, fmt.Errorf("field %q must not start with '$'", field)
+	}
+	if opts.Step <= 0 {
+		return nil, fmt.Errorf("$densify step must be positive, got %v", opts.Step)
+	}
+	if err := validateDensifyBounds(opts.Bounds); err != nil {
+		return nil, err
+	}
+
+	rng := bson.D{}
+	if opts.Unit != "" {
+		if opts.Step != float64(int64(opts.Step)) {
+			return nil, fmt.Errorf("$densify step must be a whole number when a unit is set, got %v", opts.Step)
+		}
+		rng = append(rng, bson.E{"step", int64(opts.Step)}, bson.E{"unit", opts.Unit})
+	} else {
+		rng = append(rng, bson.E{"step", opts.Step})
+	}
+	rng = append(rng, bson.E{"bounds", opts.Bounds})
+
+	stage := bson.D{{"field", field}}
+	if len(opts.PartitionByFields) > 0 {
+		stage = append(stage, bson.E{"partitionByFields", opts.PartitionByFields})
+	}
+	stage = append(stage, bson.E{"range", rng})
+
+	return bson.D{{"$densify", stage}}, nil
+}
+
+// validateDensifyBounds returns an error if bounds is not "full", "partition", or a pair of values.
+func validateDensifyBounds(bounds interface{}) error {
+	if bounds == nil {
+		return errors.New("$densify requires range bounds")
+	}
+	if s, ok := bounds.(string); ok {
+		if s != "full" && s != "partition" {
+			return fmt.Errorf(`$densify bounds must be "full", "partition", or a pair of values, got %q`, s)
+		}
+		return nil
+	}
+
+	t, data, err := bson.MarshalValue(bounds)
+	if err != nil {
+		return fmt.Errorf("unable to marshal $densify bounds: %v", err)
+	}
+	arr, ok := bson.RawValue{Type: t, Value: data}.ArrayOK()
+	if !ok {
+		return fmt.Errorf(`$densify bounds must be "full", "partition", or a pair of values, got BSON type %s`, t)
+	}
+	vals, err := arr.Values()
+	if err != nil {
+		return err
+	}
+	if len(vals) != 2 {
+		return fmt.Errorf("$densify bounds must have exactly 2 values, got %d", len(vals))
+	}
+	return nil
+}
+
+// FillOutput describes how a single output field of a $fill stage is filled. Exactly one of Value and Method must be
+// set.
+type FillOutput struct {
+	// Value is an expression used to fill missing values of the field (e.g. 0 or "$otherField").
+	Value interface{}
+
+	// Method is the method used to fill missing values of the field. It must be "linear" or "locf" (last observation
+	// carried forward).
+	Method string
+}
+
+// FillOptions contains the fields of a $fill stage.
+type FillOptions struct {
+	// PartitionBy is an expression used to group documents. PartitionBy and PartitionByFields cannot both be set.
+	PartitionBy interface{}
+
+	// PartitionByFields is the set of fields used to group documents. PartitionBy and PartitionByFields cannot both be
+	// set.
+	PartitionByFields []string
+
+	// SortBy is the sort order of documents within each partition. It is required if any output field uses a Method.
+	SortBy interface{}
+
+	// Output maps the names of the fields to fill to the way they are filled. It must not be empty.
+	Output map[string]FillOutput
+}
+
+// Fill returns a $fill stage that populates null and missing values of the fields in opts.Output. Output fields are
+// added to the stage in lexicographic order. The $fill stage requires MongoDB server version 5.3 or higher.
+//
+// An error is returned if Output is empty, if an output field does not set exactly one of Value and Method, if a
+// Method is used without a SortBy, or if both PartitionBy and PartitionByFields are set.
+//
+// Example usage:
+//
+//		stage, err := pipeline.Fill(pipeline.FillOptions{
+//			PartitionByFields: []string{"sensor"},
+//			SortBy:            bson.D{{"timestamp", 1}},
+//			Output: map[string]pipeline.FillOutput{
+//				"temperature": {Method: "linear"},
+//				"status":      {Value: "unknown"},
+//			},
+//		})
+//
+func Fill(opts FillOptions) (bson.D, error) {
+	if len(opts.Output) == 0 {
+		return nil, errors.New("$fill requires at least one output field")
+	}
+	if opts.PartitionBy != nil && len(opts.PartitionByFields) > 0 {
+		return nil, errors.New("$fill partitionBy and partitionByFields cannot both be set")
+	}
+
+	names := make([]string, 0, len(opts.Output))
+	for name := range opts.Output {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	outputDoc := make(bson.D, 0, len(names))
+	for _, name := range names {
+		out := opts.Output[name]
+		switch {
+		case out.Value != nil && out.Method != "":
+			return nil, fmt.Errorf("output field %q: value and method cannot both be set", name)
+		case out.Value != nil:
+			outputDoc = append(outputDoc, bson.E{name, bson.D{{"value", out.Value}}})
+		case out.Method == "linear" || out.Method == "locf":
+			if opts.SortBy == nil {
+				return nil, fmt.Errorf("output field %q: a sortBy is required when method %q is used", name, out.Method)
+			}
+			outputDoc = append(outputDoc, bson.E{name, bson.D{{"method", out.Method}}})
+		case out.Method != "":
+			return nil, fmt.Errorf("output field %q: method must be \"linear\" or \"locf\", got %q", name, out.Method)
+		default:
+			return nil, fmt.Errorf("output field %q: either value or method must be set", name)
+		}
+	}
+
+	var stage bson.D
+	if opts.PartitionBy != nil {
+		stage = append(stage, bson.E{"partitionBy", opts.PartitionBy})
+	}
+	if len(opts.PartitionByFields) > 0 {
+		stage = append(stage, bson.E{"partitionByFields", opts.PartitionByFields})
+	}
+	if opts.SortBy != nil {
+		stage = append(stage, bson.E{"sortBy", opts.SortBy})
+	}
+	stage = append(stage, bson.E{"output", outputDoc})
+
+	return bson.D{{"$fill", stage}}, nil
+}
+
+// GraphLookupOptions contains the optional fields of a $graphLookup stage. Fields with zero values are omitted from
+// the stage.
+type GraphLookupOptions struct {
+	// MaxDepth is the maximum recursion depth. A value of 0 only looks up the documents that directly match the
+	// startWith expression. It must not be negative. If nil, the recursion depth is not limited.
+	MaxDepth *int
+
+	// DepthField is the name of the field added to each traversed document containing the recursion depth at which
+	// the document was found.
+	DepthField string
+
+	// RestrictSearchWithMatch is a query that documents must match to be included in the search.
+	RestrictSearchWithMatch interface{}
+}
+
+// GraphLookup returns a $graphLookup stage that recursively searches the collection from, starting with the value of
+// the startWith expression and matching connectFromField of each found document against connectToField of other
+// documents. The documents found are added to each input document in the array field as.
+//
+// An error is returned if a required parameter is empty, if a field name starts with '$', or if MaxDepth is negative.
+//
+// Example usage:
+//
+//		maxDepth := 3
+//		stage, err := pipeline.GraphLookup("employees", "$reportsTo", "reportsTo", "name", "reportingHierarchy",
+//			pipeline.GraphLookupOptions{MaxDepth: &maxDepth, DepthField: "level"})
+//
+func GraphLookup(from, startWith, connectFromField, connectToField, as string, opts GraphLookupOptions) (bson.D, error) {
+	required := []struct {
+		name, value string
+	}{
+		{"from", from},
+		{"startWith", startWith},
+		{"connectFromField", connectFromField},
+		{"connectToField", connectToField},
+		{"as", as},
+	}
+	for _, param := range required {
+		if param.value == "" {
+			return nil, fmt.Errorf("$graphLookup requires %s", param.name)
+		}
+	}
+	fieldNames := []struct {
+		name, value string
+	}{
+		{"connectFromField", connectFromField},
+		{"connectToField", connectToField},
+		{"as", as},
+		{"depthField", opts.DepthField},
+	}
+	for _, field := range fieldNames {
+		if strings.HasPrefix(field.value, "$") {
+			return nil, fmt.Errorf("%s %q must not start with '$'", field.name, field.value)
+		}
+	}
+	if opts.MaxDepth != nil && *opts.MaxDepth < 0 {
+		return nil, fmt.Errorf("$graphLookup maxDepth must not be negative, got %d", *opts.MaxDepth)
+	}
+
+	stage := bson.D{
+		{"from", from},
+		{"startWith", startWith},
+		{"connectFromField", connectFromField},
+		{"connectToField", connectToField},
+		{"as", as},
+	}
+	if opts.MaxDepth != nil {
+		stage = append(stage, bson.E{"maxDepth", *opts.MaxDepth})
+	}
+	if opts.DepthField != "" {
+		stage = append(stage, bson.E{"depthField", opts.DepthField})
+	}
+	if opts.RestrictSearchWithMatch != nil {
+		stage = append(stage, bson.E{"restrictSearchWithMatch", opts.RestrictSearchWithMatch})
+	}
+
+	return bson.D{{"$graphLookup", stage}}, nil
+}
@@ -0,0 +1,342 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package pipeline
+
+import (
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/internal/testutil/assert"
+)
+
+func TestOutToDatabase(t *testing.T) {
+	got := OutToDatabase("db", "coll")
+	want := bson.D{{"$out", bson.D{{"db", "db"}, {"coll", "coll"}}}}
+	assert.Equal(t, want, got, "expected stage %v, got %v", want, got)
+}
+
+func TestSetWindowFields(t *testing.T) {
+	t.Run("valid stage", func(t *testing.T) {
+		got, err := SetWindowFields("$state", bson.D{{"orderDate", 1}}, map[string]WindowOperator{
+			"rank": {Operator: "$rank"},
+			"cumulativeQuantity": {
+				Operator:  "$sum",
+				Argument:  "$quantity",
+				Documents: []interface{}{"unbounded", "current"},
+			},
+			"recentQuantity": {
+				Operator: "$avg",
+				Argument: "$quantity",
+				Range:    []interface{}{-10, 0},
+				Unit:     "day",
+			},
+		})
+		assert.Nil(t, err, "SetWindowFields error: %v", err)
+		want := bson.D{{"$setWindowFields", bson.D{
+			{"partitionBy", "$state"},
+			{"sortBy", bson.D{{"orderDate", 1}}},
+			{"output", bson.D{
+				{"cumulativeQuantity", bson.D{
+					{"$sum", "$quantity"},
+					{"window", bson.D{{"documents", bson.A{"unbounded", "current"}}}},
+				}},
+				{"rank", bson.D{{"$rank", bson.D{}}}},
+				{"recentQuantity", bson.D{
+					{"$avg", "$quantity"},
+					{"window", bson.D{{"range", bson.A{-10, 0}}, {"unit", "day"}}},
+				}},
+			}},
+		}}}
+		assert.Equal(t, want, got, "expected stage %v, got %v", want, got)
+	})
+	t.Run("invalid stage", func(t *testing.T) {
+		testCases := []struct {
+			name   string
+			sortBy interface{}
+			output map[string]WindowOperator
+		}{
+			{"no output", bson.D{{"a", 1}}, nil},
+			{"missing operator prefix", bson.D{{"a", 1}}, map[string]WindowOperator{"x": {Operator: "sum"}}},
+			{"documents without sort", nil, map[string]WindowOperator{
+				"x": {Operator: "$sum", Argument: "$a", Documents: []interface{}{-1, 1}},
+			}},
+			{"range without sort", nil, map[string]WindowOperator{
+				"x": {Operator: "$sum", Argument: "$a", Range: []interface{}{-1, 1}},
+			}},
+			{"documents and range", bson.D{{"a", 1}}, map[string]WindowOperator{
+				"x": {Operator: "$sum", Argument: "$a", Documents: []interface{}{-1, 1}, Range: []interface{}{-1, 1}},
+			}},
+			{"wrong number of bounds", bson.D{{"a", 1}}, map[string]WindowOperator{
+				"x": {Operator: "$sum", Argument: "$a", Documents: []interface{}{-1}},
+			}},
+			{"unit without range", bson.D{{"a", 1}}, map[string]WindowOperator{
+				"x": {Operator: "$sum", Argument: "$a", Unit: "day"},
+			}},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := SetWindowFields(nil, tc.sortBy, tc.output)
+				assert.NotNil(t, err, "expected error, got nil")
+			})
+		}
+	})
+}
+
+func TestGeoNear(t *testing.T) {
+	point := bson.D{{"type", "Point"}, {"coordinates", bson.A{-73.99, 40.73}}}
+
+	t.Run("valid stage", func(t *testing.T) {
+		got, err := GeoNear(point, "dist", GeoNearOptions{
+			Spherical:   true,
+			MaxDistance: 2000,
+			Query:       bson.D{{"category", "Parks"}},
+			Key:         "location",
+		})
+		assert.Nil(t, err, "GeoNear error: %v", err)
+		want := bson.D{{"$geoNear", bson.D{
+			{"near", point},
+			{"distanceField", "dist"},
+			{"spherical", true},
+			{"maxDistance", 2000.0},
+			{"query", bson.D{{"category", "Parks"}}},
+			{"key", "location"},
+		}}}
+		assert.Equal(t, want, got, "expected stage %v, got %v", want, got)
+	})
+	t.Run("legacy coordinate pair", func(t *testing.T) {
+		_, err := GeoNear([]float64{-73.99, 40.73}, "dist", GeoNearOptions{})
+		assert.Nil(t, err, "GeoNear error: %v", err)
+	})
+	t.Run("invalid stage", func(t *testing.T) {
+		testCases := []struct {
+			name          string
+			near          interface{}
+			distanceField string
+			opts          GeoNearOptions
+		}{
+			{"nil near", nil, "dist", GeoNearOptions{}},
+			{"string near", "here", "dist", GeoNearOptions{}},
+			{"wrong GeoJSON type", bson.D{{"type", "Polygon"}, {"coordinates", bson.A{1, 2}}}, "dist", GeoNearOptions{}},
+			{"wrong number of coordinates", bson.A{1, 2, 3}, "dist", GeoNearOptions{}},
+			{"non-numeric coordinates", bson.A{"a", "b"}, "dist", GeoNearOptions{}},
+			{"missing distanceField", point, "", GeoNearOptions{}},
+			{"distanceField with $", point, "$dist", GeoNearOptions{}},
+			{"negative distance", point, "dist", GeoNearOptions{MaxDistance: -1}},
+			{"minDistance greater than maxDistance", point, "dist", GeoNearOptions{MinDistance: 10, MaxDistance: 5}},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := GeoNear(tc.near, tc.distanceField, tc.opts)
+				assert.NotNil(t, err, "expected error, got nil")
+			})
+		}
+	})
+	t.Run("position", func(t *testing.T) {
+		stage, err := GeoNear(point, "dist", GeoNearOptions{})
+		assert.Nil(t, err, "GeoNear error: %v", err)
+		match := bson.D{{"$match", bson.D{{"x", 1}}}}
+
+		err = ValidateGeoNearPosition([]bson.D{stage, match})
+		assert.Nil(t, err, "ValidateGeoNearPosition error: %v", err)
+		err = ValidateGeoNearPosition([]bson.D{match, stage})
+		assert.NotNil(t, err, "expected error, got nil")
+	})
+}
+
+func TestUnionWith(t *testing.T) {
+	match := bson.D{{"$match", bson.D{{"year", 2020}}}}
+
+	t.Run("valid stage", func(t *testing.T) {
+		got, err := UnionWith("archive", []bson.D{match})
+		assert.Nil(t, err, "UnionWith error: %v", err)
+		want := bson.D{{"$unionWith", bson.D{{"coll", "archive"}, {"pipeline", []bson.D{match}}}}}
+		assert.Equal(t, want, got, "expected stage %v, got %v", want, got)
+	})
+	t.Run("empty sub-pipeline", func(t *testing.T) {
+		got, err := UnionWith("archive", nil)
+		assert.Nil(t, err, "UnionWith error: %v", err)
+		want := bson.D{{"$unionWith", "archive"}}
+		assert.Equal(t, want, got, "expected stage %v, got %v", want, got)
+	})
+	t.Run("invalid stage", func(t *testing.T) {
+		testCases := []struct {
+			name        string
+			coll        string
+			subPipeline []bson.D
+		}{
+			{"empty collection", "", []bson.D{match}},
+			{"$out stage", "archive", []bson.D{match, OutToDatabase("db", "coll")}},
+			{"$merge stage", "archive", []bson.D{{{"$merge", bson.D{{"into", "coll"}}}}}},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := UnionWith(tc.coll, tc.subPipeline)
+				assert.NotNil(t, err, "expected error, got nil")
+			})
+		}
+	})
+}
+
+func TestSample(t *testing.T) {
+	t.Run("valid stage", func(t *testing.T) {
+		got, err := Sample(10)
+		assert.Nil(t, err, "Sample error: %v", err)
+		want := bson.D{{"$sample", bson.D{{"size", 10}}}}
+		assert.Equal(t, want, got, "expected stage %v, got %v", want, got)
+	})
+	t.Run("invalid size", func(t *testing.T) {
+		for _, size := range []int{0, -1} {
+			_, err := Sample(size)
+			assert.NotNil(t, err, "expected error for size %d, got nil", size)
+		}
+	})
+}
+
+func TestDensify(t *testing.T) {
+	t.Run("date range", func(t *testing.T) {
+		got, err := Densify("timestamp", DensifyOptions{
+			PartitionByFields: []string{"sensor"},
+			Step:              1,
+			Unit:              "hour",
+			Bounds:            "partition",
+		})
+		assert.Nil(t, err, "Densify error: %v", err)
+		want := bson.D{{"$densify", bson.D{
+			{"field", "timestamp"},
+			{"partitionByFields", []string{"sensor"}},
+			{"range", bson.D{{"step", int64(1)}, {"unit", "hour"}, {"bounds", "partition"}}},
+		}}}
+		assert.Equal(t, want, got, "expected stage %v, got %v", want, got)
+	})
+	t.Run("numeric range", func(t *testing.T) {
+		got, err := Densify("altitude", DensifyOptions{Step: 0.5, Bounds: []interface{}{0, 10}})
+		assert.Nil(t, err, "Densify error: %v", err)
+		want := bson.D{{"$densify", bson.D{
+			{"field", "altitude"},
+			{"range", bson.D{{"step", 0.5}, {"bounds", []interface{}{0, 10}}}},
+		}}}
+		assert.Equal(t, want, got, "expected stage %v, got %v", want, got)
+	})
+	t.Run("invalid stage", func(t *testing.T) {
+		testCases := []struct {
+			name  string
+			field string
+			opts  DensifyOptions
+		}{
+			{"empty field", "", DensifyOptions{Step: 1, Bounds: "full"}},
+			{"field with $", "$ts", DensifyOptions{Step: 1, Bounds: "full"}},
+			{"missing step", "ts", DensifyOptions{Bounds: "full"}},
+			{"negative step", "ts", DensifyOptions{Step: -1, Bounds: "full"}},
+			{"fractional step with unit", "ts", DensifyOptions{Step: 1.5, Unit: "hour", Bounds: "full"}},
+			{"missing bounds", "ts", DensifyOptions{Step: 1}},
+			{"unknown bounds", "ts", DensifyOptions{Step: 1, Bounds: "all"}},
+			{"wrong number of bounds", "ts", DensifyOptions{Step: 1, Bounds: bson.A{1, 2, 3}}},
+			{"non-array bounds", "ts", DensifyOptions{Step: 1, Bounds: 5}},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := Densify(tc.field, tc.opts)
+				assert.NotNil(t, err, "expected error, got nil")
+			})
+		}
+	})
+}
+
+func TestFill(t *testing.T) {
+	t.Run("valid stage", func(t *testing.T) {
+		got, err := Fill(FillOptions{
+			PartitionByFields: []string{"sensor"},
+			SortBy:            bson.D{{"timestamp", 1}},
+			Output: map[string]FillOutput{
+				"temperature": {Method: "linear"},
+				"status":      {Value: "unknown"},
+			},
+		})
+		assert.Nil(t, err, "Fill error: %v", err)
+		want := bson.D{{"$fill", bson.D{
+			{"partitionByFields", []string{"sensor"}},
+			{"sortBy", bson.D{{"timestamp", 1}}},
+			{"output", bson.D{
+				{"status", bson.D{{"value", "unknown"}}},
+				{"temperature", bson.D{{"method", "linear"}}},
+			}},
+		}}}
+		assert.Equal(t, want, got, "expected stage %v, got %v", want, got)
+	})
+	t.Run("invalid stage", func(t *testing.T) {
+		sortBy := bson.D{{"timestamp", 1}}
+		testCases := []struct {
+			name string
+			opts FillOptions
+		}{
+			{"no output", FillOptions{SortBy: sortBy}},
+			{"empty output field", FillOptions{Output: map[string]FillOutput{"x": {}}}},
+			{"value and method", FillOptions{SortBy: sortBy, Output: map[string]FillOutput{
+				"x": {Value: 0, Method: "locf"},
+			}}},
+			{"unknown method", FillOptions{SortBy: sortBy, Output: map[string]FillOutput{"x": {Method: "mean"}}}},
+			{"method without sort", FillOptions{Output: map[string]FillOutput{"x": {Method: "locf"}}}},
+			{"partitionBy and partitionByFields", FillOptions{
+				PartitionBy:       "$sensor",
+				PartitionByFields: []string{"sensor"},
+				Output:            map[string]FillOutput{"x": {Value: 0}},
+			}},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := Fill(tc.opts)
+				assert.NotNil(t, err, "expected error, got nil")
+			})
+		}
+	})
+}
+
+func TestGraphLookup(t *testing.T) {
+	t.Run("valid stage", func(t *testing.T) {
+		maxDepth := 0
+		got, err := GraphLookup("employees", "$reportsTo", "reportsTo", "name", "hierarchy", GraphLookupOptions{
+			MaxDepth:                &maxDepth,
+			DepthField:              "level",
+			RestrictSearchWithMatch: bson.D{{"active", true}},
+		})
+		assert.Nil(t, err, "GraphLookup error: %v", err)
+		want := bson.D{{"$graphLookup", bson.D{
+			{"from", "employees"},
+			{"startWith", "$reportsTo"},
+			{"connectFromField", "reportsTo"},
+			{"connectToField", "name"},
+			{"as", "hierarchy"},
+			{"maxDepth", 0},
+			{"depthField", "level"},
+			{"restrictSearchWithMatch", bson.D{{"active", true}}},
+		}}}
+		assert.Equal(t, want, got, "expected stage %v, got %v", want, got)
+	})
+	t.Run("invalid stage", func(t *testing.T) {
+		negative := -1
+		testCases := []struct {
+			name                                        string
+			from, startWith, connectFrom, connectTo, as string
+			opts                                        GraphLookupOptions
+		}{
+			{"missing from", "", "$a", "a", "b", "out", GraphLookupOptions{}},
+			{"missing startWith", "coll", "", "a", "b", "out", GraphLookupOptions{}},
+			{"missing connectFromField", "coll", "$a", "", "b", "out", GraphLookupOptions{}},
+			{"missing connectToField", "coll", "$a", "a", "", "out", GraphLookupOptions{}},
+			{"missing as", "coll", "$a", "a", "b", "", GraphLookupOptions{}},
+			{"as with $", "coll", "$a", "a", "b", "$out", GraphLookupOptions{}},
+			{"depthField with $", "coll", "$a", "a", "b", "out", GraphLookupOptions{DepthField: "$depth"}},
+			{"negative maxDepth", "coll", "$a", "a", "b", "out", GraphLookupOptions{MaxDepth: &negative}},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := GraphLookup(tc.from, tc.startWith, tc.connectFrom, tc.connectTo, tc.as, tc.opts)
+				assert.NotNil(t, err, "expected error, got nil")
+			})
+		}
+	})
+}
@@ -0,0 +1,160 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/mongo/description"
+	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
+	"go.mongodb.org/mongo-driver/x/mongo/driver"
+	"go.mongodb.org/mongo-driver/x/mongo/driver/session"
+)
+
+// readAheadResult is the outcome of a single call to Next on the wrapped batchCursor.
+type readAheadResult struct {
+	ok    bool
+	batch *bsoncore.DocumentSequence
+	id    int64
+	err   error
+}
+
+// readAheadBatchCursor is a batchCursor that fetches the next batch from the wrapped batchCursor in a background
+// goroutine while the current batch is being consumed. Each batch is copied out of the wrapped cursor because its
+// DocumentSequence is only valid until the next call to Next, so up to two batches are held in memory at a time.
+//
+// The wrapped batchCursor is only accessed by one goroutine at a time: either the prefetching goroutine or the caller
+// once the prefetch has completed.
+type readAheadBatchCursor struct {
+	bc batchCursor
+
+	batch *bsoncore.DocumentSequence
+	id    int64
+	err   error
+
+	pending chan readAheadResult
+	ctx     context.Context
+	cancel  context.CancelFunc
+}
+
+var _ batchCursor = (*readAheadBatchCursor)(nil)
+
+func newReadAheadBatchCursor(bc batchCursor) *readAheadBatchCursor {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &readAheadBatchCursor{
+		bc:     bc,
+		batch:  bc.Batch(),
+		id:     bc.ID(),
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
+// wrapReadAhead wraps bc in a readAheadBatchCursor if readAhead is set to true. Read-ahead is not used with explicit
+// sessions because the background getMore would use the session concurrently with the application.
+func wrapReadAhead(bc batchCursor, readAhead *bool, sess *session.Client) batchCursor {
+	if readAhead == nil || !*readAhead {
+		return bc
+	}
+	if sess != nil && sess.SessionType != session.Implicit {
+		return bc
+	}
+	return newReadAheadBatchCursor(bc)
+}
+
+// ID implements the batchCursor interface.
+func (r *readAheadBatchCursor) ID() int64 { return r.id }
+
+// Next implements the batchCursor interface. If a prefetch is in flight, Next waits for it to complete or for ctx to
+// expire. Prefetched getMore commands are not bound by the ctx passed to Next. They are cancelled when the cursor is
+// closed.
+func (r *readAheadBatchCursor) Next(ctx context.Context) bool {
+	if r.err != nil {
+		return false
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	var res readAheadResult
+	if r.pending == nil {
+		res = r.fetch(ctx)
+	} else {
+		select {
+		case res = <-r.pending:
+			r.pending = nil
+		case <-ctx.Done():
+			r.err = ctx.Err()
+			return false
+		}
+	}
+
+	r.batch = res.batch
+	r.id = res.id
+	r.err = res.err
+	if r.err == nil && r.id != 0 {
+		r.prefetch()
+	}
+	return res.ok
+}
+
+// fetch calls Next on the wrapped batchCursor and copies the resulting batch.
+func (r *readAheadBatchCursor) fetch(ctx context.Context) readAheadResult {
+	ok := r.bc.Next(ctx)
+	return readAheadResult{
+		ok:    ok,
+		batch: copyDocumentSequence(r.bc.Batch()),
+		id:    r.bc.ID(),
+		err:   r.bc.Err(),
+	}
+}
+
+func (r *readAheadBatchCursor) prefetch() {
+	pending := make(chan readAheadResult, 1)
+	r.pending = pending
+	go func() {
+		pending <- r.fetch(r.ctx)
+	}()
+}
+
+// Batch implements the batchCursor interface.
+func (r *readAheadBatchCursor) Batch() *bsoncore.DocumentSequence { return r.batch }
+
+// Server implements the batchCursor interface.
+func (r *readAheadBatchCursor) Server() driver.Server { return r.bc.Server() }
+
+// ServerDescription returns the description of the server of the wrapped batchCursor, if it has one.
+func (r *readAheadBatchCursor) ServerDescription() description.Server {
+	if sd, ok := r.bc.(serverDescriber); ok {
+		return sd.ServerDescription()
+	}
+	return description.Server{}
+}
+
+// Err implements the batchCursor interface.
+func (r *readAheadBatchCursor) Err() error { return r.err }
+
+// Close implements the batchCursor interface. Any in-flight prefetch is cancelled and waited for before the wrapped
+// batchCursor is closed.
+func (r *readAheadBatchCursor) Close(ctx context.Context) error {
+	r.cancel()
+	if r.pending != nil {
+		<-r.pending
+		r.pending = nil
+	}
+	return r.bc.Close(ctx)
+}
+
+func copyDocumentSequence(ds *bsoncore.DocumentSequence) *bsoncore.DocumentSequence {
+	if ds == nil {
+		return &bsoncore.DocumentSequence{}
+	}
+	return &bsoncore.DocumentSequence{
+		Style: ds.Style,
+		Data:  append([]byte(nil), ds.Data...),
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"context"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/internal/testutil/assert"
+	"go.mongodb.org/mongo-driver/x/mongo/driver/session"
+)
+
+func TestReadAheadBatchCursor(t *testing.T) {
+	t.Run("iterates all documents in order", func(t *testing.T) {
+		tbc := newTestBatchCursor(4, 3)
+		cursor, err := newCursor(newReadAheadBatchCursor(tbc), nil)
+		assert.Nil(t, err, "newCursor error: %v", err)
+
+		var count int32
+		for cursor.Next(context.Background()) {
+			expected := bson.D{{"foo", count}}
+			var doc bson.D
+			err = cursor.Decode(&doc)
+			assert.Nil(t, err, "Decode error: %v", err)
+			assert.Equal(t, expected, doc, "expected doc %v, got %v", expected, doc)
+			count++
+		}
+		assert.Nil(t, cursor.Err(), "cursor error: %v", cursor.Err())
+		assert.Equal(t, int32(12), count, "expected 12 documents, got %v", count)
+		assert.Equal(t, int64(0), cursor.ID(), "expected cursor ID 0, got %v", cursor.ID())
+	})
+	t.Run("close waits for prefetch", func(t *testing.T) {
+		tbc := newTestBatchCursor(3, 2)
+		cursor, err := newCursor(newReadAheadBatchCursor(tbc), nil)
+		assert.Nil(t, err, "newCursor error: %v", err)
+
+		assert.True(t, cursor.Next(context.Background()), "expected Next to return true")
+		err = cursor.Close(context.Background())
+		assert.Nil(t, err, "Close error: %v", err)
+		assert.True(t, tbc.closed, "expected batch cursor to be closed")
+	})
+	t.Run("cancelled context", func(t *testing.T) {
+		cursor, err := newCursor(newReadAheadBatchCursor(newTestBatchCursor(2, 2)), nil)
+		assert.Nil(t, err, "newCursor error: %v", err)
+		defer cursor.Close(context.Background())
+
+		ctx, cancel := context.WithCancel(context.Background())
+		assert.True(t, cursor.Next(ctx), "expected Next to return true")
+		assert.True(t, cursor.Next(ctx), "expected Next to return true")
+		cancel()
+		for cursor.Next(ctx) {
+		}
+		// The prefetch may complete before the cancellation is observed, so the cursor either ends with a context
+		// error or after returning every document.
+		if err := cursor.Err(); err != nil {
+			assert.Equal(t, context.Canceled, err, "expected error %v, got %v", context.Canceled, err)
+		}
+	})
+	t.Run("not used with explicit sessions", func(t *testing.T) {
+		readAhead := true
+		tbc := newTestBatchCursor(1, 1)
+
+		bc := wrapReadAhead(tbc, &readAhead, &session.Client{SessionType: session.Explicit})
+		_, ok := bc.(*readAheadBatchCursor)
+		assert.False(t, ok, "expected read-ahead to be disabled for explicit sessions")
+
+		bc = wrapReadAhead(tbc, &readAhead, nil)
+		_, ok = bc.(*readAheadBatchCursor)
+		assert.True(t, ok, "expected read-ahead to be enabled")
+	})
+}
@@ -20,7 +20,8 @@ var ErrInvalidTagSet = errors.New("an even number of tags must be specified")
 type Option func(*ReadPref) error
 
 // WithMaxStaleness sets the maximum staleness a
-// server is allowed.
+// server is allowed. Stale servers are filtered out
+// before the latency window is applied.
 func WithMaxStaleness(ms time.Duration) Option {
 	return func(rp *ReadPref) error {
 		rp.maxStaleness = ms
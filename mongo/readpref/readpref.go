@@ -48,6 +48,9 @@ func Secondary(opts ...Option) *ReadPref {
 }
 
 // Nearest constructs a read preference with a NearestMode.
+//
+// If a max staleness is set, servers that are estimated to be staler than the max staleness are filtered out before
+// the latency window is applied, so a nearby server that is lagging behind the primary will not be selected.
 func Nearest(opts ...Option) *ReadPref {
 	// New only returns an error with a mode of Primary
 	rp, _ := New(NearestMode, opts...)
@@ -8,6 +8,7 @@ package mongo
 
 import (
 	"fmt"
+	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -183,6 +184,54 @@ func (i *IndexSpecification) UnmarshalBSON(data []byte) error {
 	return nil
 }
 
+// IndexUsage contains usage statistics for an index. This type is returned by the Collection.IndexUsageStats function.
+type IndexUsage struct {
+	// The index name.
+	Name string
+
+	// The keys specification document for the index.
+	Key bson.Raw
+
+	// The host and port of the mongod that reported the statistics.
+	Host string
+
+	// The number of operations that used the index since Since.
+	AccessOps int64
+
+	// The time at which the server started gathering statistics for the index. The statistics are reset when the
+	// mongod restarts or the index is rebuilt.
+	Since time.Time
+}
+
+var _ bson.Unmarshaler = (*IndexUsage)(nil)
+
+// unmarshalIndexUsage is used to unmarshal a document returned by the $indexStats aggregation stage into an
+// IndexUsage.
+type unmarshalIndexUsage struct {
+	Name     string   `bson:"name"`
+	Key      bson.Raw `bson:"key"`
+	Host     string   `bson:"host"`
+	Accesses struct {
+		Ops   int64     `bson:"ops"`
+		Since time.Time `bson:"since"`
+	} `bson:"accesses"`
+}
+
+// UnmarshalBSON implements the bson.Unmarshaler interface.
+func (i *IndexUsage) UnmarshalBSON(data []byte) error {
+	var temp unmarshalIndexUsage
+	if err := bson.Unmarshal(data, &temp); err != nil {
+		return err
+	}
+
+	i.Name = temp.Name
+	i.Key = temp.Key
+	i.Host = temp.Host
+	i.AccessOps = temp.Accesses.Ops
+	i.Since = temp.Accesses.Since
+	return nil
+}
+
 // CollectionSpecification represents a collection in a database. This type is returned by the
 // Database.ListCollectionSpecifications function.
 type CollectionSpecification struct {
@@ -244,3 +293,53 @@ func (cs *CollectionSpecification) UnmarshalBSON(data []byte) error {
 	cs.IDIndex = temp.IDIndex
 	return nil
 }
+
+// OpInfo represents an operation in progress on a server. This type is returned by the Database.CurrentOp function.
+type OpInfo struct {
+	// The ID of the operation. This is an int32 for mongod servers and a string in the format "shardName:opID" for
+	// mongos servers. It can be passed to the killOp command to terminate the operation.
+	OpID interface{}
+
+	// The namespace the operation targets. This is a string in the format "databaseName.collectionName".
+	Namespace string
+
+	// The type of operation, e.g. "query", "insert", "command", or "none" for idle connections.
+	Op string
+
+	// The number of seconds the operation has been running. This will be zero for idle connections.
+	SecsRunning int64
+
+	// Whether or not the operation is waiting for a lock.
+	WaitingForLock bool
+
+	// The command document for the operation.
+	Command bson.Raw
+}
+
+var _ bson.Unmarshaler = (*OpInfo)(nil)
+
+// unmarshalOpInfo is used to unmarshal BSON bytes from a $currentOp aggregation into an OpInfo.
+type unmarshalOpInfo struct {
+	OpID           interface{} `bson:"opid"`
+	Namespace      string      `bson:"ns"`
+	Op             string      `bson:"op"`
+	SecsRunning    int64       `bson:"secs_running"`
+	WaitingForLock bool        `bson:"waitingForLock"`
+	Command        bson.Raw    `bson:"command"`
+}
+
+// UnmarshalBSON implements the bson.Unmarshaler interface.
+func (oi *OpInfo) UnmarshalBSON(data []byte) error {
+	var temp unmarshalOpInfo
+	if err := bson.Unmarshal(data, &temp); err != nil {
+		return err
+	}
+
+	oi.OpID = temp.OpID
+	oi.Namespace = temp.Namespace
+	oi.Op = temp.Op
+	oi.SecsRunning = temp.SecsRunning
+	oi.WaitingForLock = temp.WaitingForLock
+	oi.Command = temp.Command
+	return nil
+}
@@ -8,6 +8,7 @@ package mongo
 
 import (
 	"testing"
+	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/internal/testutil/assert"
@@ -76,4 +77,69 @@ func TestResults(t *testing.T) {
 			assert.Equal(t, int32(3), upsertedID, "expected upsertedID 3, got %v", upsertedID)
 		})
 	})
+	t.Run("op info", func(t *testing.T) {
+		t.Run("unmarshal into", func(t *testing.T) {
+			cmd := bson.D{{"find", "coll"}, {"filter", bson.D{}}}
+			doc := bson.D{
+				{"opid", "shard01:1234"},
+				{"ns", "db.coll"},
+				{"op", "query"},
+				{"secs_running", int32(5)},
+				{"waitingForLock", true},
+				{"command", cmd},
+			}
+
+			b, err := bson.Marshal(doc)
+			assert.Nil(t, err, "Marshal error: %v", err)
+			cmdBytes, err := bson.Marshal(cmd)
+			assert.Nil(t, err, "Marshal error: %v", err)
+
+			var info OpInfo
+			err = bson.Unmarshal(b, &info)
+			assert.Nil(t, err, "Unmarshal error: %v", err)
+
+			expected := OpInfo{
+				OpID:           "shard01:1234",
+				Namespace:      "db.coll",
+				Op:             "query",
+				SecsRunning:    5,
+				WaitingForLock: true,
+				Command:        cmdBytes,
+			}
+			assert.Equal(t, expected, info, "expected OpInfo %v, got %v", expected, info)
+		})
+	})
+	t.Run("index usage", func(t *testing.T) {
+		t.Run("unmarshal into", func(t *testing.T) {
+			key := bson.D{{"x", int32(1)}}
+			since := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+			doc := bson.D{
+				{"name", "x_1"},
+				{"key", key},
+				{"host", "localhost:27017"},
+				{"accesses", bson.D{
+					{"ops", int64(42)},
+					{"since", since},
+				}},
+			}
+
+			b, err := bson.Marshal(doc)
+			assert.Nil(t, err, "Marshal error: %v", err)
+			keyBytes, err := bson.Marshal(key)
+			assert.Nil(t, err, "Marshal error: %v", err)
+
+			var usage IndexUsage
+			err = bson.Unmarshal(b, &usage)
+			assert.Nil(t, err, "Unmarshal error: %v", err)
+
+			expected := IndexUsage{
+				Name:      "x_1",
+				Key:       keyBytes,
+				Host:      "localhost:27017",
+				AccessOps: 42,
+				Since:     since,
+			}
+			assert.Equal(t, expected, usage, "expected IndexUsage %v, got %v", expected, usage)
+		})
+	})
 }
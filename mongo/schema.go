@@ -0,0 +1,86 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"sort"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+)
+
+// bsonTypeAliases maps BSON types to the aliases used by the $type query operator.
+var bsonTypeAliases = map[bsontype.Type]string{
+	bsontype.Double:           "double",
+	bsontype.String:           "string",
+	bsontype.EmbeddedDocument: "object",
+	bsontype.Array:            "array",
+	bsontype.Binary:           "binData",
+	bsontype.Undefined:        "undefined",
+	bsontype.ObjectID:         "objectId",
+	bsontype.Boolean:          "bool",
+	bsontype.DateTime:         "date",
+	bsontype.Null:             "null",
+	bsontype.Regex:            "regex",
+	bsontype.DBPointer:        "dbPointer",
+	bsontype.JavaScript:       "javascript",
+	bsontype.Symbol:           "symbol",
+	bsontype.CodeWithScope:    "javascriptWithScope",
+	bsontype.Int32:            "int",
+	bsontype.Timestamp:        "timestamp",
+	bsontype.Int64:            "long",
+	bsontype.Decimal128:       "decimal",
+	bsontype.MinKey:           "minKey",
+	bsontype.MaxKey:           "maxKey",
+}
+
+// schemaSample records the set of BSON types seen for each field path in a sample of documents.
+type schemaSample map[string]map[string]struct{}
+
+// add records the types of the fields in doc. Field paths are prefixed by prefix.
+func (s schemaSample) add(prefix string, doc bson.Raw) error {
+	elems, err := doc.Elements()
+	if err != nil {
+		return err
+	}
+
+	for _, elem := range elems {
+		path := prefix + elem.Key()
+		val := elem.Value()
+
+		alias, ok := bsonTypeAliases[val.Type]
+		if !ok {
+			alias = val.Type.String()
+		}
+		if s[path] == nil {
+			s[path] = make(map[string]struct{})
+		}
+		s[path][alias] = struct{}{}
+
+		if val.Type == bsontype.EmbeddedDocument {
+			if err = s.add(path+".", val.Document()); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// types returns the type aliases for each field path, joining conflicting types with "|".
+func (s schemaSample) types() map[string]string {
+	types := make(map[string]string, len(s))
+	for path, aliases := range s {
+		names := make([]string, 0, len(aliases))
+		for alias := range aliases {
+			names = append(names, alias)
+		}
+		sort.Strings(names)
+		types[path] = strings.Join(names, "|")
+	}
+	return types
+}
@@ -0,0 +1,48 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"context"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/internal/testutil/assert"
+)
+
+func TestSchemaSample(t *testing.T) {
+	docs := []interface{}{
+		bson.D{{"_id", primitive.NewObjectID()}, {"x", int32(1)}, {"sub", bson.D{{"y", "foo"}}}},
+		bson.D{{"_id", primitive.NewObjectID()}, {"x", "one"}, {"tags", bson.A{"a", "b"}}},
+		bson.D{{"_id", primitive.NewObjectID()}, {"x", nil}, {"sub", bson.D{{"y", int64(2)}}}},
+	}
+
+	schema := make(schemaSample)
+	for _, doc := range docs {
+		raw, err := bson.Marshal(doc)
+		assert.Nil(t, err, "Marshal error: %v", err)
+		err = schema.add("", raw)
+		assert.Nil(t, err, "add error: %v", err)
+	}
+
+	want := map[string]string{
+		"_id":   "objectId",
+		"x":     "int|null|string",
+		"sub":   "object",
+		"sub.y": "long|string",
+		"tags":  "array",
+	}
+	got := schema.types()
+	assert.Equal(t, want, got, "expected schema %v, got %v", want, got)
+}
+
+func TestAggregateSchemaSampleSize(t *testing.T) {
+	coll := &Collection{}
+	_, err := coll.AggregateSchema(context.Background(), Pipeline{}, 0)
+	assert.NotNil(t, err, "expected error, got nil")
+}
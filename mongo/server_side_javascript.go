@@ -0,0 +1,51 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
+)
+
+// serverSideJavaScriptOperators contains the query and aggregation operators that cause the server to execute
+// JavaScript.
+var serverSideJavaScriptOperators = map[string]struct{}{
+	"$where":       {},
+	"$function":    {},
+	"$accumulator": {},
+}
+
+// rejectServerSideJavaScript walks the given document and returns an error if it contains any operator that would cause
+// the server to execute JavaScript. It is used as the topology's command validator when the RejectServerSideJavaScript
+// client option is set.
+func rejectServerSideJavaScript(doc bsoncore.Document) error {
+	elems, err := doc.Elements()
+	if err != nil {
+		return err
+	}
+
+	for _, elem := range elems {
+		if _, ok := serverSideJavaScriptOperators[elem.Key()]; ok {
+			return fmt.Errorf("server-side JavaScript is disabled for this client but the command contains the %s operator",
+				elem.Key())
+		}
+
+		val := elem.Value()
+		switch val.Type {
+		case bsontype.EmbeddedDocument:
+			err = rejectServerSideJavaScript(val.Document())
+		case bsontype.Array:
+			err = rejectServerSideJavaScript(bsoncore.Document(val.Array()))
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/internal/testutil/assert"
+)
+
+func TestRejectServerSideJavaScript(t *testing.T) {
+	testCases := []struct {
+		name   string
+		cmd    bson.D
+		reject bool
+	}{
+		{"no operators", bson.D{{"find", "foo"}, {"filter", bson.D{{"x", 1}}}}, false},
+		{"$where in filter", bson.D{{"find", "foo"}, {"filter", bson.D{{"$where", "this.x == 1"}}}}, true},
+		{"$where nested in $or", bson.D{
+			{"find", "foo"},
+			{"filter", bson.D{{"$or", bson.A{bson.D{{"x", 1}}, bson.D{{"$where", "true"}}}}}},
+		}, true},
+		{"$function in pipeline", bson.D{
+			{"aggregate", "foo"},
+			{"pipeline", bson.A{bson.D{{"$addFields", bson.D{{"y", bson.D{{"$function", bson.D{}}}}}}}}},
+		}, true},
+		{"$accumulator in pipeline", bson.D{
+			{"aggregate", "foo"},
+			{"pipeline", bson.A{bson.D{{"$group", bson.D{{"_id", nil}, {"y", bson.D{{"$accumulator", bson.D{}}}}}}}}},
+		}, true},
+		{"operator name as value", bson.D{{"find", "foo"}, {"filter", bson.D{{"x", "$where"}}}}, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd, err := bson.Marshal(tc.cmd)
+			assert.Nil(t, err, "Marshal error: %v", err)
+
+			err = rejectServerSideJavaScript(cmd)
+			if tc.reject {
+				assert.NotNil(t, err, "expected command %v to be rejected", tc.cmd)
+				return
+			}
+			assert.Nil(t, err, "expected command %v to be allowed, got error %v", tc.cmd, err)
+		})
+	}
+}
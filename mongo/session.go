@@ -104,6 +104,16 @@ func SessionFromContext(ctx context.Context) Session {
 // time, the Client associated with the session, and the ID document associated with the session, respectively. The ID
 // document for a session is in the form {"id": <BSON binary value>}.
 //
+// WillStartTransactionCommand returns true if the next operation executed with this session will be the first command
+// of the active transaction. Only that command carries the transaction's read concern (e.g. snapshot); subsequent
+// commands in the transaction are sent without a read concern.
+//
+// RecoveryToken returns the recovery token for the session's current transaction and true if one has been received.
+// Recovery tokens are only sent by mongos, so this returns false for transactions on replica sets or if no command in
+// the transaction has been executed. The token is cleared when a new transaction is started. It can be used to
+// diagnose commits on sharded clusters that fail with an UnknownTransactionCommitResult error. The returned document
+// must not be modified.
+//
 // EndSession method should abort any existing transactions and close the session.
 //
 // AdvanceClusterTime and AdvanceOperationTime are for internal use only and must not be called.
@@ -121,6 +131,8 @@ type Session interface {
 	OperationTime() *primitive.Timestamp
 	Client() *Client
 	ID() bson.Raw
+	WillStartTransactionCommand() bool
+	RecoveryToken() (bson.Raw, bool)
 
 	// Functions to modify mutable session properties.
 	AdvanceClusterTime(bson.Raw) error
@@ -158,6 +170,17 @@ func (s *sessionImpl) ID() bson.Raw {
 	return bson.Raw(s.clientSession.SessionID)
 }
 
+// WillStartTransactionCommand implements the Session interface.
+func (s *sessionImpl) WillStartTransactionCommand() bool {
+	return s.clientSession.TransactionStarting()
+}
+
+// RecoveryToken implements the Session interface.
+func (s *sessionImpl) RecoveryToken() (bson.Raw, bool) {
+	token := s.clientSession.RecoveryToken
+	return token, token != nil
+}
+
 // EndSession implements the Session interface.
 func (s *sessionImpl) EndSession(ctx context.Context) {
 	if s.clientSession.TransactionInProgress() {
@@ -268,8 +291,8 @@ func (s *sessionImpl) AbortTransaction(ctx context.Context) error {
 
 	s.clientSession.Aborting = true
 	_ = operation.NewAbortTransaction().Session(s.clientSession).ClusterClock(s.client.clock).Database("admin").
-		Deployment(s.deployment).WriteConcern(s.clientSession.CurrentWc).ServerSelector(selector).
-		Retry(driver.RetryOncePerCommand).CommandMonitor(s.client.monitor).
+		Deployment(s.deployment).WriteConcern(s.clientSession.CurrentWc).ServerSelector(selector).Timeout(s.client.timeout).
+		Retry(driver.RetryOncePerCommand).RetryTimeout(s.client.retryTimeout).CommandMonitor(s.client.monitor).
 		RecoveryToken(bsoncore.Document(s.clientSession.RecoveryToken)).Execute(ctx)
 
 	s.clientSession.Aborting = false
@@ -301,6 +324,7 @@ func (s *sessionImpl) CommitTransaction(ctx context.Context) error {
 	op := operation.NewCommitTransaction().
 		Session(s.clientSession).ClusterClock(s.client.clock).Database("admin").Deployment(s.deployment).
 		WriteConcern(s.clientSession.CurrentWc).ServerSelector(selector).Retry(driver.RetryOncePerCommand).
+		RetryTimeout(s.client.retryTimeout).Timeout(s.client.timeout).
 		CommandMonitor(s.client.monitor).RecoveryToken(bsoncore.Document(s.clientSession.RecoveryToken))
 	if s.clientSession.CurrentMct != nil {
 		op.MaxTimeMS(int64(*s.clientSession.CurrentMct / time.Millisecond))
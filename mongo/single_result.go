@@ -12,6 +12,7 @@ import (
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/bsoncodec"
+	"go.mongodb.org/mongo-driver/tag"
 )
 
 // ErrNoDocuments is returned by SingleResult methods when the operation that created the SingleResult did not return
@@ -22,10 +23,11 @@ var ErrNoDocuments = errors.New("mongo: no documents in result")
 // SingleResult methods will return that error. If the operation did not return any documents, all SingleResult methods
 // will return ErrNoDocuments.
 type SingleResult struct {
-	err error
-	cur *Cursor
-	rdr bson.Raw
-	reg *bsoncodec.Registry
+	err  error
+	cur  *Cursor
+	rdr  bson.Raw
+	reg  *bsoncodec.Registry
+	tags tag.Set
 }
 
 // Decode will unmarshal the document represented by this SingleResult into v. If there was an error from the operation
@@ -62,6 +64,28 @@ func (sr *SingleResult) DecodeBytes() (bson.Raw, error) {
 	return sr.rdr, nil
 }
 
+// PresentFields returns the names of the top-level fields present in the document represented by this SingleResult,
+// in the order they appear in the document. This can be used to distinguish fields that were absent from the returned
+// document, such as fields excluded by a projection, from fields that were present with a zero value. If there was an
+// error from the operation that created this SingleResult, that error will be returned. If the operation returned no
+// documents, PresentFields will return ErrNoDocuments.
+func (sr *SingleResult) PresentFields() ([]string, error) {
+	rdr, err := sr.DecodeBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	elems, err := rdr.Elements()
+	if err != nil {
+		return nil, err
+	}
+	fields := make([]string, 0, len(elems))
+	for _, elem := range elems {
+		fields = append(fields, elem.Key())
+	}
+	return fields, nil
+}
+
 // setRdrContents will set the contents of rdr by iterating the underlying cursor if necessary.
 func (sr *SingleResult) setRdrContents() error {
 	switch {
@@ -85,6 +109,16 @@ func (sr *SingleResult) setRdrContents() error {
 	return ErrNoDocuments
 }
 
+// SelectedServerTags returns the tags of the server that executed the operation that created this SingleResult. This
+// is only available for SingleResults returned by FindOne and RunCommand. If the server has no tags, the operation did
+// not select a server, or the SingleResult was created by another operation, nil is returned.
+func (sr *SingleResult) SelectedServerTags() tag.Set {
+	if sr.cur != nil {
+		return sr.cur.SelectedServerTags()
+	}
+	return sr.tags
+}
+
 // Err returns the error from the operation that created this SingleResult. If the operation was successful but did not
 // return any documents, Err will return ErrNoDocuments. If the operation was successful and returned a document, Err
 // will return nil.
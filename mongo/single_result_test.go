@@ -44,6 +44,24 @@ func TestSingleResult(t *testing.T) {
 		})
 	})
 
+	t.Run("PresentFields", func(t *testing.T) {
+		t.Run("fields", func(t *testing.T) {
+			doc, err := bson.Marshal(bson.D{{"_id", 1}, {"name", ""}, {"count", 0}})
+			assert.Nil(t, err, "Marshal error: %v", err)
+			sr := &SingleResult{rdr: doc, reg: bson.DefaultRegistry}
+
+			fields, err := sr.PresentFields()
+			assert.Nil(t, err, "PresentFields error: %v", err)
+			want := []string{"_id", "name", "count"}
+			assert.Equal(t, want, fields, "expected fields %v, got %v", want, fields)
+		})
+		t.Run("no documents", func(t *testing.T) {
+			sr := &SingleResult{}
+			_, err := sr.PresentFields()
+			assert.Equal(t, ErrNoDocuments, err, "expected error %v, got %v", ErrNoDocuments, err)
+		})
+	})
+
 	t.Run("Err", func(t *testing.T) {
 		sr := &SingleResult{}
 		assert.Equal(t, ErrNoDocuments, sr.Err(), "expected error %v, got %v", ErrNoDocuments, sr.Err())
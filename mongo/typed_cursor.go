@@ -0,0 +1,94 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+//go:build go1.21
+// +build go1.21
+
+package mongo
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// TypedCursor is a Cursor that decodes each document into a value of type T. Like Cursor, a TypedCursor is not
+// goroutine safe and must be closed once it is no longer needed.
+//
+// TypedCursor is only available when building with Go 1.21 or later, the first release that allows a file to use a
+// newer language version than the one declared in go.mod.
+type TypedCursor[T any] struct {
+	cursor  *Cursor
+	current T
+	err     error
+}
+
+// NewTypedCursor creates a TypedCursor that decodes the documents returned by cursor into values of type T.
+func NewTypedCursor[T any](cursor *Cursor) *TypedCursor[T] {
+	return &TypedCursor[T]{cursor: cursor}
+}
+
+// FindTyped executes a find command against coll and returns a TypedCursor over the matching documents. The filter
+// and opts parameters have the same requirements as the corresponding parameters for Collection.Find.
+func FindTyped[T any](ctx context.Context, coll *Collection, filter interface{},
+	opts ...*options.FindOptions) (*TypedCursor[T], error) {
+
+	cursor, err := coll.Find(ctx, filter, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return NewTypedCursor[T](cursor), nil
+}
+
+// Next gets the next document for this cursor and decodes it into a value of type T, which can be retrieved with
+// Current. It returns true if there were no errors and the cursor has not been exhausted. If decoding a document
+// fails, Next returns false and the error is reported by Err.
+func (tc *TypedCursor[T]) Next(ctx context.Context) bool {
+	if tc.err != nil || !tc.cursor.Next(ctx) {
+		return false
+	}
+
+	var val T
+	if err := tc.cursor.Decode(&val); err != nil {
+		tc.err = err
+		return false
+	}
+	tc.current = val
+	return true
+}
+
+// Current returns the value decoded by the last successful call to Next.
+func (tc *TypedCursor[T]) Current() T {
+	return tc.current
+}
+
+// All iterates the cursor and decodes each remaining document into a value of type T. The cursor is closed once all
+// documents have been decoded or an error occurs.
+func (tc *TypedCursor[T]) All(ctx context.Context) ([]T, error) {
+	if tc.err != nil {
+		_ = tc.cursor.Close(ctx)
+		return nil, tc.err
+	}
+
+	results := make([]T, 0)
+	if err := tc.cursor.All(ctx, &results); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// Err returns the last error seen by the TypedCursor, or nil if no error has occurred.
+func (tc *TypedCursor[T]) Err() error {
+	if tc.err != nil {
+		return tc.err
+	}
+	return tc.cursor.Err()
+}
+
+// Close closes this cursor.
+func (tc *TypedCursor[T]) Close(ctx context.Context) error {
+	return tc.cursor.Close(ctx)
+}
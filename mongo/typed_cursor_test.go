@@ -0,0 +1,60 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+//go:build go1.21
+// +build go1.21
+
+package mongo
+
+import (
+	"context"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/internal/testutil/assert"
+)
+
+type typedCursorDoc struct {
+	Foo int32 `bson:"foo"`
+}
+
+func TestTypedCursor(t *testing.T) {
+	t.Run("next", func(t *testing.T) {
+		cursor, err := newCursor(newTestBatchCursor(2, 2), nil)
+		assert.Nil(t, err, "newCursor error: %v", err)
+		tc := NewTypedCursor[typedCursorDoc](cursor)
+
+		var got []int32
+		for tc.Next(context.Background()) {
+			got = append(got, tc.Current().Foo)
+		}
+		assert.Nil(t, tc.Err(), "cursor error: %v", tc.Err())
+		expected := []int32{0, 1, 2, 3}
+		assert.Equal(t, expected, got, "expected values %v, got %v", expected, got)
+	})
+	t.Run("all", func(t *testing.T) {
+		cursor, err := newCursor(newTestBatchCursor(2, 2), nil)
+		assert.Nil(t, err, "newCursor error: %v", err)
+		tc := NewTypedCursor[typedCursorDoc](cursor)
+
+		docs, err := tc.All(context.Background())
+		assert.Nil(t, err, "All error: %v", err)
+		expected := []typedCursorDoc{{0}, {1}, {2}, {3}}
+		assert.Equal(t, expected, docs, "expected documents %v, got %v", expected, docs)
+	})
+	t.Run("decode error", func(t *testing.T) {
+		cursor, err := newCursor(newTestBatchCursor(1, 2), nil)
+		assert.Nil(t, err, "newCursor error: %v", err)
+		tc := NewTypedCursor[struct {
+			Foo string `bson:"foo"`
+		}](cursor)
+
+		assert.False(t, tc.Next(context.Background()), "expected Next to return false, got true")
+		assert.NotNil(t, tc.Err(), "expected cursor error, got nil")
+		assert.False(t, tc.Next(context.Background()), "expected Next to return false, got true")
+		_, err = tc.All(context.Background())
+		assert.NotNil(t, err, "expected All error, got nil")
+	})
+}
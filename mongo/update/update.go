@@ -0,0 +1,163 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+// Package update provides a builder for update documents that can be passed to the UpdateOne, UpdateMany, and
+// FindOneAndUpdate methods of mongo.Collection.
+package update
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// Builder builds an update document from update operators. Fields are grouped under their operator in the order in
+// which the operators are first used. Because a Builder only produces operator expressions, the resulting document
+// can never mix update operators with replacement fields.
+//
+// The first invalid call is recorded and returned by Build, and all later calls are ignored. A call is invalid if its
+// field name is empty or starts with '$', or if it updates a field that conflicts with a field updated by an earlier
+// call (e.g. "a" and "a.b"), which the server would reject.
+//
+// Example usage:
+//
+//		doc, err := update.New().Set("status", "A").Inc("count", 1).Unset("legacy").Build()
+//
+type Builder struct {
+	ops    bson.D
+	fields []string
+	err    error
+}
+
+// New creates a new, empty Builder.
+func New() *Builder {
+	return &Builder{}
+}
+
+// Set adds a $set expression that sets field to value.
+func (b *Builder) Set(field string, value interface{}) *Builder {
+	return b.add("$set", field, value)
+}
+
+// SetOnInsert adds a $setOnInsert expression that sets field to value if the update results in an insert.
+func (b *Builder) SetOnInsert(field string, value interface{}) *Builder {
+	return b.add("$setOnInsert", field, value)
+}
+
+// Unset adds an $unset expression that removes field.
+func (b *Builder) Unset(field string) *Builder {
+	return b.add("$unset", field, "")
+}
+
+// Inc adds an $inc expression that increments field by amount.
+func (b *Builder) Inc(field string, amount interface{}) *Builder {
+	return b.add("$inc", field, amount)
+}
+
+// Mul adds a $mul expression that multiplies field by factor.
+func (b *Builder) Mul(field string, factor interface{}) *Builder {
+	return b.add("$mul", field, factor)
+}
+
+// Min adds a $min expression that sets field to value if value is less than the current value of field.
+func (b *Builder) Min(field string, value interface{}) *Builder {
+	return b.add("$min", field, value)
+}
+
+// Max adds a $max expression that sets field to value if value is greater than the current value of field.
+func (b *Builder) Max(field string, value interface{}) *Builder {
+	return b.add("$max", field, value)
+}
+
+// CurrentDate adds a $currentDate expression that sets field to the current date.
+func (b *Builder) CurrentDate(field string) *Builder {
+	return b.add("$currentDate", field, true)
+}
+
+// Rename adds a $rename expression that renames the field from to to. Both fields are checked for conflicts with
+// other expressions.
+func (b *Builder) Rename(from, to string) *Builder {
+	if b.err == nil {
+		b.err = b.checkField(to)
+	}
+	if b.err == nil {
+		b.fields = append(b.fields, to)
+	}
+	return b.add("$rename", from, to)
+}
+
+// Push adds a $push expression that appends value to the array field.
+func (b *Builder) Push(field string, value interface{}) *Builder {
+	return b.add("$push", field, value)
+}
+
+// AddToSet adds an $addToSet expression that appends value to the array field if it is not already present.
+func (b *Builder) AddToSet(field string, value interface{}) *Builder {
+	return b.add("$addToSet", field, value)
+}
+
+// Pull adds a $pull expression that removes all elements of the array field that match condition. The condition can
+// be a value or a query document.
+func (b *Builder) Pull(field string, condition interface{}) *Builder {
+	return b.add("$pull", field, condition)
+}
+
+// Build returns the update document. An error is returned if no expressions were added or if any call to the Builder
+// was invalid.
+func (b *Builder) Build() (bson.D, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	if len(b.ops) == 0 {
+		return nil, errors.New("update document must contain at least one update operator")
+	}
+
+	doc := make(bson.D, 0, len(b.ops))
+	for _, op := range b.ops {
+		fields := op.Value.(bson.D)
+		doc = append(doc, bson.E{op.Key, append(bson.D(nil), fields...)})
+	}
+	return doc, nil
+}
+
+func (b *Builder) add(op, field string, value interface{}) *Builder {
+	if b.err != nil {
+		return b
+	}
+	if b.err = b.checkField(field); b.err != nil {
+		return b
+	}
+	b.fields = append(b.fields, field)
+
+	elem := bson.E{field, value}
+	for i, existing := range b.ops {
+		if existing.Key == op {
+			b.ops[i].Value = append(existing.Value.(bson.D), elem)
+			return b
+		}
+	}
+	b.ops = append(b.ops, bson.E{op, bson.D{elem}})
+	return b
+}
+
+// checkField returns an error if field is not a valid field name or conflicts with a field that has already been
+// added. Two fields conflict if they are equal or one is a prefix of the other's dotted path.
+func (b *Builder) checkField(field string) error {
+	if field == "" {
+		return errors.New("update field name must not be empty")
+	}
+	if strings.HasPrefix(field, "$") {
+		return fmt.Errorf("update field name %q must not start with '$'", field)
+	}
+	for _, existing := range b.fields {
+		if existing == field || strings.HasPrefix(field, existing+".") || strings.HasPrefix(existing, field+".") {
+			return fmt.Errorf("updating field %q conflicts with the update of field %q", field, existing)
+		}
+	}
+	return nil
+}
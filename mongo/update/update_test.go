@@ -0,0 +1,63 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package update
+
+import (
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/internal/testutil/assert"
+)
+
+func TestBuilder(t *testing.T) {
+	t.Run("groups fields by operator", func(t *testing.T) {
+		got, err := New().Set("a", 1).Inc("n", 1).Unset("b").Set("c.d", "x").Rename("old", "new").Build()
+		assert.Nil(t, err, "Build error: %v", err)
+		want := bson.D{
+			{"$set", bson.D{{"a", 1}, {"c.d", "x"}}},
+			{"$inc", bson.D{{"n", 1}}},
+			{"$unset", bson.D{{"b", ""}}},
+			{"$rename", bson.D{{"old", "new"}}},
+		}
+		assert.Equal(t, want, got, "expected update %v, got %v", want, got)
+	})
+	t.Run("build returns a copy", func(t *testing.T) {
+		b := New().Set("a", 1)
+		first, err := b.Build()
+		assert.Nil(t, err, "Build error: %v", err)
+		first[0].Value.(bson.D)[0].Value = 2
+
+		second, err := b.Build()
+		assert.Nil(t, err, "Build error: %v", err)
+		want := bson.D{{"$set", bson.D{{"a", 1}}}}
+		assert.Equal(t, want, second, "expected update %v, got %v", want, second)
+	})
+	t.Run("invalid updates", func(t *testing.T) {
+		testCases := []struct {
+			name    string
+			builder *Builder
+		}{
+			{"empty", New()},
+			{"empty field", New().Set("", 1)},
+			{"operator as field", New().Set("$inc", bson.D{{"n", 1}})},
+			{"same field", New().Set("a", 1).Inc("a", 1)},
+			{"parent field", New().Set("a.b", 1).Unset("a")},
+			{"child field", New().Set("a", 1).Set("a.b", 1)},
+			{"rename target", New().Set("b", 1).Rename("a", "b")},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := tc.builder.Build()
+				assert.NotNil(t, err, "expected error, got nil")
+			})
+		}
+	})
+	t.Run("prefix without dot does not conflict", func(t *testing.T) {
+		_, err := New().Set("a", 1).Set("ab", 1).Build()
+		assert.Nil(t, err, "Build error: %v", err)
+	})
+}
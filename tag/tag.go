@@ -66,6 +66,27 @@ func (ts Set) ContainsAll(other []Tag) bool {
 	return true
 }
 
+// MatchesTagSets indicates whether a server with the given tags matches any of the tag sets of a read preference. The
+// server matches if it contains all of the name/value pairs in one of them. An empty list of tag sets and an empty tag
+// set both match every server.
+//
+// This checks a single server on its own, so it does not decide whether the server would be selected. Server selection
+// uses only the first tag set that matches any eligible server, so a server that matches only a later tag set is not
+// selected while a server matching an earlier one is available.
+func MatchesTagSets(serverTags Set, tagSets []Set) bool {
+	if len(tagSets) == 0 {
+		return true
+	}
+
+	for _, ts := range tagSets {
+		if serverTags.ContainsAll(ts) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // String returns a human-readable human-readable description of the tagset.
 func (ts Set) String() string {
 	var b bytes.Buffer
@@ -94,3 +94,28 @@ func TestTagSets_String(t *testing.T) {
 	}
 	assert.Equal(t, "a=1,b=2", ts.String(), `expected "a=1,b=2", got %q`, ts.String())
 }
+
+func TestMatchesTagSets(t *testing.T) {
+	t.Parallel()
+
+	serverTags := Set{{Name: "dc", Value: "east"}, {Name: "rack", Value: "1"}}
+
+	testCases := []struct {
+		name    string
+		tagSets []Set
+		matches bool
+	}{
+		{"no tag sets", nil, true},
+		{"empty tag set", []Set{{}}, true},
+		{"matching set", []Set{{{Name: "dc", Value: "east"}}}, true},
+		{"all pairs required", []Set{{{Name: "dc", Value: "east"}, {Name: "rack", Value: "2"}}}, false},
+		{"later set matches", []Set{{{Name: "dc", Value: "west"}}, {{Name: "rack", Value: "1"}}}, true},
+		{"empty set after non-matching set", []Set{{{Name: "dc", Value: "west"}}, {}}, true},
+		{"no matching set", []Set{{{Name: "dc", Value: "west"}}}, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.matches, MatchesTagSets(serverTags, tc.tagSets))
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"go.mongodb.org/mongo-driver/bson/bsontype"
 	"go.mongodb.org/mongo-driver/event"
@@ -23,8 +24,11 @@ type BatchCursor struct {
 	id                   int64
 	err                  error
 	server               Server
+	serverDescription    description.Server
 	batchSize            int32
 	maxTimeMS            int64
+	comment              bsoncore.Value
+	timeout              *time.Duration
 	currentBatch         *bsoncore.DocumentSequence
 	firstBatch           bool
 	cmdMonitor           *event.CommandMonitor
@@ -101,6 +105,8 @@ type CursorOptions struct {
 	BatchSize      int32
 	MaxTimeMS      int64
 	Limit          int32
+	Comment        bsoncore.Value
+	Timeout        *time.Duration
 	CommandMonitor *event.CommandMonitor
 	Crypt          *Crypt
 }
@@ -115,8 +121,11 @@ func NewBatchCursor(cr CursorResponse, clientSession *session.Client, clock *ses
 		collection:           cr.Collection,
 		id:                   cr.ID,
 		server:               cr.Server,
+		serverDescription:    cr.Desc,
 		batchSize:            opts.BatchSize,
 		maxTimeMS:            opts.MaxTimeMS,
+		comment:              opts.Comment,
+		timeout:              opts.Timeout,
 		cmdMonitor:           opts.CommandMonitor,
 		firstBatch:           true,
 		postBatchResumeToken: cr.postBatchResumeToken,
@@ -208,6 +217,12 @@ func (bc *BatchCursor) Server() Server {
 	return bc.server
 }
 
+// ServerDescription returns the description of the server that the cursor was created on, as reported when the
+// connection used to create the cursor was established.
+func (bc *BatchCursor) ServerDescription() description.Server {
+	return bc.serverDescription
+}
+
 func (bc *BatchCursor) clearBatch() {
 	bc.currentBatch.Data = bc.currentBatch.Data[:0]
 }
@@ -252,6 +267,16 @@ func (bc *BatchCursor) getMore(ctx context.Context) {
 		}
 	}
 
+	// Each getMore is given its own deadline, so the timeout is applied here rather than through Operation.Timeout,
+	// which would also add a maxTimeMS to the command.
+	if bc.timeout != nil && *bc.timeout > 0 {
+		if _, ok := ctx.Deadline(); !ok {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, *bc.timeout)
+			defer cancel()
+		}
+	}
+
 	bc.err = Operation{
 		CommandFn: func(dst []byte, desc description.SelectedServer) ([]byte, error) {
 			dst = bsoncore.AppendInt64Element(dst, "getMore", bc.id)
@@ -262,6 +287,10 @@ func (bc *BatchCursor) getMore(ctx context.Context) {
 			if bc.maxTimeMS > 0 {
 				dst = bsoncore.AppendInt64Element(dst, "maxTimeMS", bc.maxTimeMS)
 			}
+			// getMore only accepts a comment on server versions 4.4 and above.
+			if bc.comment.Type != bsontype.Type(0) && desc.WireVersion != nil && desc.WireVersion.Includes(9) {
+				dst = bsoncore.AppendValueElement(dst, "comment", bc.comment)
+			}
 			return dst, nil
 		},
 		Database:   bc.database,
@@ -320,3 +349,9 @@ func (bc *BatchCursor) getMore(ctx context.Context) {
 func (bc *BatchCursor) PostBatchResumeToken() bsoncore.Document {
 	return bc.postBatchResumeToken
 }
+
+// SetMaxTime sets the maxTimeMS value sent with subsequent getMore commands. A value of 0 omits maxTimeMS from the
+// commands.
+func (bc *BatchCursor) SetMaxTime(dur time.Duration) {
+	bc.maxTimeMS = int64(dur / time.Millisecond)
+}
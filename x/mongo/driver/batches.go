@@ -2,6 +2,7 @@ package driver
 
 import (
 	"errors"
+	"fmt"
 
 	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
 )
@@ -14,6 +15,28 @@ const reservedCommandBufferBytes = 16 * 10 * 10 * 10
 // server is passed to an insert command.
 var ErrDocumentTooLarge = errors.New("an inserted document is too large")
 
+// DocumentTooLargeError is returned when a document passed to a write command is larger than the maximum document size
+// accepted by the selected server. It wraps ErrDocumentTooLarge, which AdvanceBatch returns when it reaches an oversized
+// document, so errors.Is(err, ErrDocumentTooLarge) reports true for both.
+type DocumentTooLargeError struct {
+	// Index is the index of the document in the documents passed to the operation.
+	Index int
+	// Size is the size of the document in bytes.
+	Size int
+	// MaxSize is the maximum document size in bytes accepted by the server.
+	MaxSize int
+}
+
+func (e DocumentTooLargeError) Error() string {
+	return fmt.Sprintf("document at index %d is %d bytes, which exceeds the maximum document size of %d bytes",
+		e.Index, e.Size, e.MaxSize)
+}
+
+// Unwrap returns ErrDocumentTooLarge.
+func (e DocumentTooLargeError) Unwrap() error {
+	return ErrDocumentTooLarge
+}
+
 // Batches contains the necessary information to batch split an operation. This is only used for write
 // oeprations.
 type Batches struct {
@@ -31,6 +54,17 @@ func (b *Batches) Valid() bool { return b != nil && b.Identifier != "" && len(b.
 // next batch.
 func (b *Batches) ClearBatch() { b.Current = b.Current[:0] }
 
+// ValidateDocumentSizes returns a DocumentTooLargeError for the first document in Documents that is larger than
+// maxDocSize.
+func (b *Batches) ValidateDocumentSizes(maxDocSize int) error {
+	for i, doc := range b.Documents {
+		if len(doc) > maxDocSize {
+			return DocumentTooLargeError{Index: i, Size: len(doc), MaxSize: maxDocSize}
+		}
+	}
+	return nil
+}
+
 // AdvanceBatch splits the next batch using maxCount and targetBatchSize. This method will do nothing if
 // the current batch has not been cleared. We do this so that when this is called during execute we
 // can call it without first needing to check if we already have a batch, which makes the code
@@ -1,6 +1,7 @@
 package driver
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -128,4 +129,16 @@ func TestBatches(t *testing.T) {
 			assert.Equal(t, want, batches, "expected batches %v, got %v", want, batches)
 		})
 	})
+	t.Run("ValidateDocumentSizes", func(t *testing.T) {
+		docs := []bsoncore.Document{make(bsoncore.Document, 100), make(bsoncore.Document, 100), make(bsoncore.Document, 200)}
+		batches := &Batches{Documents: docs}
+
+		err := batches.ValidateDocumentSizes(200)
+		assert.Nil(t, err, "ValidateDocumentSizes error: %v", err)
+
+		err = batches.ValidateDocumentSizes(150)
+		want := DocumentTooLargeError{Index: 2, Size: 200, MaxSize: 150}
+		assert.Equal(t, want, err, "expected error %v, got %v", want, err)
+		assert.True(t, errors.Is(err, ErrDocumentTooLarge), "expected error %v to wrap %v", err, ErrDocumentTooLarge)
+	})
 }
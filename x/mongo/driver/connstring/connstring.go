@@ -80,6 +80,11 @@ type ConnString struct {
 	MinPoolSizeSet                     bool
 	Password                           string
 	PasswordSet                        bool
+	ProxyHost                          string
+	ProxyPort                          int
+	ProxyPortSet                       bool
+	ProxyUsername                      string
+	ProxyPassword                      string
 	ReadConcernLevel                   string
 	ReadPreference                     string
 	ReadPreferenceTagSets              []map[string]string
@@ -111,6 +116,8 @@ type ConnString struct {
 	SSLCaFileSet                       bool
 	SSLDisableOCSPEndpointCheck        bool
 	SSLDisableOCSPEndpointCheckSet     bool
+	Timeout                            time.Duration
+	TimeoutSet                         bool
 	WString                            string
 	WNumber                            int
 	WNumberSet                         bool
@@ -325,6 +332,10 @@ func (p *parser) validate() error {
 		return err
 	}
 
+	if err = p.validateProxy(); err != nil {
+		return err
+	}
+
 	// Check for invalid write concern (i.e. w=0 and j=true)
 	if p.WNumberSet && p.WNumber == 0 && p.JSet && p.J {
 		return writeconcern.ErrInconsistent
@@ -483,6 +494,19 @@ func (p *parser) validateAuth() error {
 	return nil
 }
 
+func (p *parser) validateProxy() error {
+	if p.ProxyHost == "" {
+		if p.ProxyPortSet || p.ProxyUsername != "" || p.ProxyPassword != "" {
+			return errors.New("proxyPort, proxyUsername, and proxyPassword cannot be specified without proxyHost")
+		}
+		return nil
+	}
+	if (p.ProxyUsername == "") != (p.ProxyPassword == "") {
+		return errors.New("proxyUsername and proxyPassword must be specified together")
+	}
+	return nil
+}
+
 func (p *parser) validateSSL() error {
 	if !p.SSL {
 		return nil
@@ -671,6 +695,19 @@ func (p *parser) addOption(pair string) error {
 		}
 		p.MinPoolSize = uint64(n)
 		p.MinPoolSizeSet = true
+	case "proxyhost":
+		p.ProxyHost = value
+	case "proxyport":
+		n, err := strconv.Atoi(value)
+		if err != nil || n <= 0 || n > 65535 {
+			return fmt.Errorf("invalid value for %s: %s", key, value)
+		}
+		p.ProxyPort = n
+		p.ProxyPortSet = true
+	case "proxyusername":
+		p.ProxyUsername = value
+	case "proxypassword":
+		p.ProxyPassword = value
 	case "readconcernlevel":
 		p.ReadConcernLevel = value
 	case "readpreference":
@@ -736,6 +773,13 @@ func (p *parser) addOption(pair string) error {
 		}
 		p.SocketTimeout = time.Duration(n) * time.Millisecond
 		p.SocketTimeoutSet = true
+	case "timeoutms":
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 0 {
+			return fmt.Errorf("invalid value for %s: %s", key, value)
+		}
+		p.Timeout = time.Duration(n) * time.Millisecond
+		p.TimeoutSet = true
 	case "ssl", "tls":
 		switch value {
 		case "true":
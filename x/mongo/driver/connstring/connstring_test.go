@@ -319,6 +319,48 @@ func TestMinPoolSize(t *testing.T) {
 	}
 }
 
+func TestProxy(t *testing.T) {
+	tests := []struct {
+		s        string
+		host     string
+		port     int
+		username string
+		password string
+		err      bool
+	}{
+		{s: "proxyHost=proxy.example.com", host: "proxy.example.com"},
+		{s: "proxyHost=proxy.example.com&proxyPort=3128", host: "proxy.example.com", port: 3128},
+		{
+			s:        "proxyHost=proxy.example.com&proxyUsername=user&proxyPassword=pwd",
+			host:     "proxy.example.com",
+			username: "user",
+			password: "pwd",
+		},
+		{s: "proxyHost=proxy.example.com&proxyPort=0", err: true},
+		{s: "proxyHost=proxy.example.com&proxyPort=abc", err: true},
+		{s: "proxyHost=proxy.example.com&proxyUsername=user", err: true},
+		{s: "proxyPort=3128", err: true},
+		{s: "proxyUsername=user&proxyPassword=pwd", err: true},
+	}
+
+	for _, test := range tests {
+		s := fmt.Sprintf("mongodb://localhost/?%s", test.s)
+		t.Run(s, func(t *testing.T) {
+			cs, err := connstring.ParseAndValidate(s)
+			if test.err {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.host, cs.ProxyHost)
+				require.Equal(t, test.port, cs.ProxyPort)
+				require.Equal(t, test.port != 0, cs.ProxyPortSet)
+				require.Equal(t, test.username, cs.ProxyUsername)
+				require.Equal(t, test.password, cs.ProxyPassword)
+			}
+		})
+	}
+}
+
 func TestReadPreference(t *testing.T) {
 	tests := []struct {
 		s        string
@@ -537,6 +579,33 @@ func TestSocketTimeout(t *testing.T) {
 	}
 }
 
+func TestTimeout(t *testing.T) {
+	tests := []struct {
+		s        string
+		expected time.Duration
+		err      bool
+	}{
+		{s: "timeoutMS=10", expected: time.Duration(10) * time.Millisecond},
+		{s: "timeoutMS=0", expected: time.Duration(0)},
+		{s: "timeoutMS=-2", err: true},
+		{s: "timeoutMS=gsdge", err: true},
+	}
+
+	for _, test := range tests {
+		s := fmt.Sprintf("mongodb://localhost/?%s", test.s)
+		t.Run(s, func(t *testing.T) {
+			cs, err := connstring.ParseAndValidate(s)
+			if test.err {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, cs.Timeout)
+				require.True(t, cs.TimeoutSet)
+			}
+		})
+	}
+}
+
 func TestWTimeout(t *testing.T) {
 	tests := []struct {
 		s        string
@@ -14,6 +14,22 @@ type Deployment interface {
 	Kind() description.TopologyKind
 }
 
+// CommandValidator is an optional interface that can be implemented by a Deployment to inspect every command before it
+// is sent to the server. ValidateCommand is called with the command built by the operation, before the driver adds
+// fields such as the session ID, read concern, and write concern. If ValidateCommand returns an error, the command is
+// not sent, the session is left unchanged, and the error is returned by Operation.Execute. For commands that carry a
+// batch of documents (e.g. insert or update), ValidateCommand is also called for each document in the batch.
+type CommandValidator interface {
+	ValidateCommand(bsoncore.Document) error
+}
+
+// OperationTracker is an optional interface that can be implemented by a Deployment to track the operations executed
+// against it. TrackOperation is called at the start of Operation.Execute and returns the context the operation should
+// use along with a function that is called when the operation completes.
+type OperationTracker interface {
+	TrackOperation(context.Context) (context.Context, func())
+}
+
 // Connector represents a type that can connect to a server.
 type Connector interface {
 	Connect() error
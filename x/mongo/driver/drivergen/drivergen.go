@@ -234,6 +234,10 @@ type Properties struct {
 	Legacy                         LegacyOperation
 	MinimumWriteConcernWireVersion int
 	MinimumReadConcernWireVersion  int
+
+	// SkippableDocumentSizeValidation adds a SkipDocumentSizeValidation setter that disables the check that each
+	// document in the batches is no larger than the maximum document size of the selected server.
+	SkippableDocumentSizeValidation bool
 }
 
 // Builtins returns a slice of built-ins that is the combination of the non-disabled default
@@ -248,6 +252,7 @@ func (p Properties) Builtins() []Builtin {
 		ClusterClock:   {},
 		Collection:     {},
 		Crypt:          {},
+		Timeout:        {},
 	}
 	for _, builtin := range p.Disabled {
 		delete(defaults, builtin)
@@ -278,9 +283,12 @@ func (p Properties) Builtins() []Builtin {
 	if _, ok := defaults[Crypt]; ok {
 		builtins = append(builtins, Crypt)
 	}
+	if _, ok := defaults[Timeout]; ok {
+		builtins = append(builtins, Timeout)
+	}
 	for _, builtin := range p.Enabled {
 		switch builtin {
-		case Deployment, Database, Selector, CommandMonitor, ClientSession, ClusterClock, Collection, Crypt:
+		case Deployment, Database, Selector, CommandMonitor, ClientSession, ClusterClock, Collection, Crypt, Timeout:
 			continue // If someone added a default to enable, just ignore it.
 		}
 		builtins = append(builtins, builtin)
@@ -393,6 +401,7 @@ const (
 	Database       Builtin = "database"
 	Deployment     Builtin = "deployment"
 	Crypt          Builtin = "crypt"
+	Timeout        Builtin = "timeout"
 )
 
 // ExecuteName provides the name used when setting this built-in on a driver.Operation.
@@ -419,6 +428,8 @@ func (b Builtin) ExecuteName() string {
 		execname = "Deployment"
 	case Crypt:
 		execname = "Crypt"
+	case Timeout:
+		execname = "Timeout"
 	}
 	return execname
 }
@@ -450,6 +461,8 @@ func (b Builtin) ReferenceName() string {
 		refname = "deployment"
 	case Crypt:
 		refname = "crypt"
+	case Timeout:
+		refname = "timeout"
 	}
 	return refname
 }
@@ -480,6 +493,8 @@ func (b Builtin) SetterName() string {
 		setter = "Deployment"
 	case Crypt:
 		setter = "Crypt"
+	case Timeout:
+		setter = "Timeout"
 	}
 	return setter
 }
@@ -510,6 +525,8 @@ func (b Builtin) Type() string {
 		t = "driver.Deployment"
 	case Crypt:
 		t = "*driver.Crypt"
+	case Timeout:
+		t = "*time.Duration"
 	}
 	return t
 }
@@ -540,6 +557,8 @@ func (b Builtin) Documentation() string {
 		doc = "Deployment sets the deployment to use for this operation."
 	case Crypt:
 		doc = "Crypt sets the Crypt object to use for automatic encryption and decryption."
+	case Timeout:
+		doc = "Timeout sets the timeout for this operation."
 	}
 	return doc
 }
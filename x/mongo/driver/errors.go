@@ -102,6 +102,16 @@ func (wce WriteCommandError) Error() string {
 	return buf.String()
 }
 
+// HasErrorLabel returns true if the error contains the specified label.
+func (wce WriteCommandError) HasErrorLabel(label string) bool {
+	for _, l := range wce.Labels {
+		if l == label {
+			return true
+		}
+	}
+	return false
+}
+
 // Retryable returns true if the error is retryable
 func (wce WriteCommandError) Retryable(wireVersion *description.VersionRange) bool {
 	for _, label := range wce.Labels {
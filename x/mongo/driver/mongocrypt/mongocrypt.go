@@ -198,6 +198,26 @@ func (m *MongoCrypt) CreateExplicitEncryptionContext(doc bsoncore.Document, opts
 		return nil, ctx.createErrorFromStatus()
 	}
 
+	if opts.QueryType != "" {
+		queryStr := C.CString(opts.QueryType)
+		defer C.free(unsafe.Pointer(queryStr))
+		if ok := C.mongocrypt_ctx_setopt_query_type(ctx.wrapped, queryStr, -1); !ok {
+			return nil, ctx.createErrorFromStatus()
+		}
+	}
+	if opts.ContentionFactor != nil {
+		if ok := C.mongocrypt_ctx_setopt_contention_factor(ctx.wrapped, C.int64_t(*opts.ContentionFactor)); !ok {
+			return nil, ctx.createErrorFromStatus()
+		}
+	}
+	if opts.RangeOptions != nil {
+		rangeBinary := newBinaryFromBytes(rangeOptionsDocument(opts.RangeOptions))
+		defer rangeBinary.close()
+		if ok := C.mongocrypt_ctx_setopt_algorithm_range(ctx.wrapped, rangeBinary.wrapped); !ok {
+			return nil, ctx.createErrorFromStatus()
+		}
+	}
+
 	docBinary := newBinaryFromBytes(doc)
 	defer docBinary.close()
 	if ok := C.mongocrypt_ctx_explicit_encrypt_init(ctx.wrapped, docBinary.wrapped); !ok {
@@ -207,6 +227,23 @@ func (m *MongoCrypt) CreateExplicitEncryptionContext(doc bsoncore.Document, opts
 	return ctx, nil
 }
 
+// rangeOptionsDocument creates the document libmongocrypt expects for the range index options.
+func rangeOptionsDocument(ro *options.ExplicitRangeOptions) bsoncore.Document {
+	idx, doc := bsoncore.AppendDocumentStart(nil)
+	if ro.Min != nil {
+		doc = bsoncore.AppendValueElement(doc, "min", *ro.Min)
+	}
+	if ro.Max != nil {
+		doc = bsoncore.AppendValueElement(doc, "max", *ro.Max)
+	}
+	doc = bsoncore.AppendInt64Element(doc, "sparsity", ro.Sparsity)
+	if ro.Precision != nil {
+		doc = bsoncore.AppendInt32Element(doc, "precision", *ro.Precision)
+	}
+	doc, _ = bsoncore.AppendDocumentEnd(doc, idx)
+	return doc
+}
+
 // CreateExplicitDecryptionContext creates a Context to use for explicit decryption.
 func (m *MongoCrypt) CreateExplicitDecryptionContext(doc bsoncore.Document) (*Context, error) {
 	ctx := newContext(C.mongocrypt_ctx_new(m.wrapped))
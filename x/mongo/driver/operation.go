@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
 	"time"
@@ -13,6 +14,8 @@ import (
 	"go.mongodb.org/mongo-driver/bson/bsontype"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/event"
+	"go.mongodb.org/mongo-driver/internal"
+	"go.mongodb.org/mongo-driver/mongo/address"
 	"go.mongodb.org/mongo-driver/mongo/description"
 	"go.mongodb.org/mongo-driver/mongo/readconcern"
 	"go.mongodb.org/mongo-driver/mongo/readpref"
@@ -171,6 +174,12 @@ type Operation struct {
 	// Batches.
 	Batches *Batches
 
+	// SkipDocumentSizeValidation disables the check that every document in Batches is no larger than the maximum
+	// document size of the selected server. By default, the documents are checked before any of them are sent and a
+	// DocumentTooLargeError is returned for the first document that is too large. If this is true, oversized documents
+	// are sent to the server, which will reject them.
+	SkipDocumentSizeValidation bool
+
 	// Legacy sets the legacy type for this operation. There are only 3 types that require legacy
 	// support: find, getMore, and killCursors. For more information about LegacyOperationKind,
 	// please refer to it's definition.
@@ -182,6 +191,19 @@ type Operation struct {
 
 	// Crypt specifies a Crypt object to use for automatic client side encryption and decryption.
 	Crypt *Crypt
+
+	// Timeout is the amount of time that this operation can execute before returning an error. If the context passed
+	// to Execute does not have a deadline, one is derived from this field and covers server selection, all retries,
+	// and the socket reads and writes for each attempt. While a deadline is in effect, a maxTimeMS value is derived
+	// from the remaining time and added to the command unless the command already specifies one. A value of 0 means
+	// that there is no timeout.
+	Timeout *time.Duration
+
+	// RetryTimeout is the amount of time after the first attempt of a write operation during which it can be retried.
+	// For a batched write, it starts again with the first attempt of each batch. Once it has elapsed, the error from the
+	// last attempt is returned instead of retrying. A nil value means that
+	// retries are only bounded by RetryMode and the deadline of the context passed to Execute.
+	RetryTimeout *time.Duration
 }
 
 // shouldEncrypt returns true if this operation should automatically be encrypted.
@@ -235,6 +257,20 @@ func (op Operation) Execute(ctx context.Context, scratch []byte) error {
 		return err
 	}
 
+	if tracker, ok := op.Deployment.(OperationTracker); ok {
+		var done func()
+		ctx, done = tracker.TrackOperation(ctx)
+		defer done()
+	}
+
+	if op.Timeout != nil && *op.Timeout > 0 {
+		if _, ok := ctx.Deadline(); !ok {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, *op.Timeout)
+			defer cancel()
+		}
+	}
+
 	srvr, err := op.selectServer(ctx)
 	if err != nil {
 		return err
@@ -268,6 +304,13 @@ func (op Operation) Execute(ctx context.Context, scratch []byte) error {
 		}
 	}
 
+	// Check the document sizes before the retry setup below so that a rejected write does not use a transaction number.
+	if op.Batches.Valid() && !op.SkipDocumentSizeValidation {
+		if err = op.Batches.ValidateDocumentSizes(int(desc.MaxDocumentSize)); err != nil {
+			return err
+		}
+	}
+
 	var res bsoncore.Document
 	var operationErr WriteCommandError
 	var original error
@@ -305,11 +348,18 @@ func (op Operation) Execute(ctx context.Context, scratch []byte) error {
 	}
 	batching := op.Batches.Valid()
 	retryEnabled := op.RetryMode != nil && op.RetryMode.Enabled()
+	var retryDeadline time.Time
+	if op.Type == Write && op.RetryTimeout != nil {
+		retryDeadline = time.Now().Add(*op.RetryTimeout)
+	}
 	currIndex := 0
 	for {
 		if batching {
 			targetBatchSize := desc.MaxDocumentSize
 			maxDocSize := desc.MaxDocumentSize
+			if op.SkipDocumentSizeValidation {
+				maxDocSize = math.MaxInt32
+			}
 			if op.shouldEncrypt() {
 				// For client-side encryption, we want the batch to be split at 2 MiB instead of 16MiB.
 				// If there's only one document in the batch, it can be up to 16MiB, so we set target batch size to
@@ -325,6 +375,12 @@ func (op Operation) Execute(ctx context.Context, scratch []byte) error {
 			}
 		}
 
+		// Validate before creating the wire message because adding the session fields changes the session's
+		// transaction state.
+		if err = op.validateCommand(desc); err != nil {
+			return err
+		}
+
 		// convert to wire message
 		if len(scratch) > 0 {
 			scratch = scratch[:0]
@@ -394,7 +450,7 @@ func (op Operation) Execute(ctx context.Context, scratch []byte) error {
 				tt.Labels = append(tt.Labels, RetryableWriteError)
 			}
 
-			if retryable && retryableErr && retries != 0 {
+			if retryable && retryableErr && retries != 0 && (retryDeadline.IsZero() || time.Now().Before(retryDeadline)) {
 				retries--
 				original, err = err, nil
 				conn.Close() // Avoid leaking the connection.
@@ -447,9 +503,18 @@ func (op Operation) Execute(ctx context.Context, scratch []byte) error {
 				}
 				return err
 			}
-			operationErr.WriteConcernError = tt.WriteConcernError
+			// Unordered batches keep executing after write errors, which are already appended across batches. Keep
+			// the WriteConcernError and labels from earlier batches as well so that a later batch without them does
+			// not clear them.
+			if tt.WriteConcernError != nil {
+				operationErr.WriteConcernError = tt.WriteConcernError
+			}
 			operationErr.WriteErrors = append(operationErr.WriteErrors, tt.WriteErrors...)
-			operationErr.Labels = tt.Labels
+			for _, label := range tt.Labels {
+				if !operationErr.HasErrorLabel(label) {
+					operationErr.Labels = append(operationErr.Labels, label)
+				}
+			}
 		case Error:
 			if tt.HasErrorLabel(TransientTransactionError) || tt.HasErrorLabel(UnknownTransactionCommitResult) {
 				op.Client.ClearPinnedServer()
@@ -474,7 +539,7 @@ func (op Operation) Execute(ctx context.Context, scratch []byte) error {
 				retryableErr = tt.RetryableRead()
 			}
 
-			if retryable && retryableErr && retries != 0 {
+			if retryable && retryableErr && retries != 0 && (retryDeadline.IsZero() || time.Now().Before(retryDeadline)) {
 				retries--
 				original, err = err, nil
 				conn.Close() // Avoid leaking the connection.
@@ -523,6 +588,9 @@ func (op Operation) Execute(ctx context.Context, scratch []byte) error {
 					retries = 1
 				}
 			}
+			if !retryDeadline.IsZero() {
+				retryDeadline = time.Now().Add(*op.RetryTimeout)
+			}
 			currIndex += len(op.Batches.Current)
 			op.Batches.ClearBatch()
 			continue
@@ -616,7 +684,7 @@ func (op Operation) readWireMessage(ctx context.Context, conn Connection, wm []b
 	res, err := op.decodeResult(wm)
 	// Update cluster/operation time and recovery tokens before handling the error to ensure we're properly updating
 	// everything.
-	op.updateClusterTimes(res)
+	op.updateClusterTimes(res, conn.Address())
 	op.updateOperationTime(res)
 	op.Client.UpdateRecoveryToken(bson.Raw(res))
 
@@ -699,6 +767,31 @@ func (op Operation) createWireMessage(ctx context.Context, dst []byte,
 	return op.createMsgWireMessage(ctx, dst, desc, conn)
 }
 
+// validateCommand runs the Deployment's CommandValidator, if it has one, against the command built by CommandFn and
+// each document in the current batch.
+func (op Operation) validateCommand(desc description.SelectedServer) error {
+	validator, ok := op.Deployment.(CommandValidator)
+	if !ok {
+		return nil
+	}
+
+	elems, err := op.CommandFn(nil, desc)
+	if err != nil {
+		return err
+	}
+	if err = validator.ValidateCommand(bsoncore.BuildDocument(nil, elems)); err != nil {
+		return err
+	}
+	if op.Batches.Valid() {
+		for _, doc := range op.Batches.Current {
+			if err = validator.ValidateCommand(doc); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func (op Operation) addBatchArray(dst []byte) []byte {
 	aidx, dst := bsoncore.AppendArrayElementStart(dst, op.Batches.Identifier)
 	for i, doc := range op.Batches.Current {
@@ -803,6 +896,10 @@ func (op Operation) createMsgWireMessage(ctx context.Context, dst []byte, desc d
 	if err != nil {
 		return dst, info, err
 	}
+	dst, err = op.addMaxTimeMS(ctx, dst[idx+4:], dst)
+	if err != nil {
+		return dst, info, err
+	}
 	dst, err = op.addReadConcern(dst, desc)
 	if err != nil {
 		return dst, info, err
@@ -852,6 +949,39 @@ func (op Operation) createMsgWireMessage(ctx context.Context, dst []byte, desc d
 	return bsoncore.UpdateLength(dst, wmindex, int32(len(dst[wmindex:]))), info, nil
 }
 
+// addMaxTimeMS appends a maxTimeMS value derived from the context deadline if a timeout is set for this operation and
+// the command elements do not already include maxTimeMS. If the deadline has already passed,
+// context.DeadlineExceeded is returned.
+func (op Operation) addMaxTimeMS(ctx context.Context, elems, dst []byte) ([]byte, error) {
+	if op.Timeout == nil {
+		return dst, nil
+	}
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return dst, nil
+	}
+	for len(elems) > 0 {
+		elem, rem, ok := bsoncore.ReadElement(elems)
+		if !ok {
+			break
+		}
+		if elem.Key() == "maxTimeMS" {
+			return dst, nil
+		}
+		elems = rem
+	}
+
+	remaining := time.Until(deadline)
+	if remaining <= 0 {
+		return dst, context.DeadlineExceeded
+	}
+	maxTimeMS := int64(remaining / time.Millisecond)
+	if maxTimeMS == 0 {
+		maxTimeMS = 1
+	}
+	return bsoncore.AppendInt64Element(dst, "maxTimeMS", maxTimeMS), nil
+}
+
 // addCommandFields adds the fields for a command to the wire message in dst. This assumes that the start of the document
 // has already been added and does not add the final 0 byte.
 func (op Operation) addCommandFields(ctx context.Context, dst []byte, desc description.SelectedServer) ([]byte, error) {
@@ -892,6 +1022,11 @@ func (op Operation) addReadConcern(dst []byte, desc description.SelectedServer)
 	}
 	rc := op.ReadConcern
 	client := op.Client
+	// Read concern can only be specified on the first command in a transaction, so subsequent commands must not carry
+	// one even if the operation was configured with a read concern.
+	if client != nil && client.TransactionInProgress() {
+		return dst, nil
+	}
 	// Starting transaction's read concern overrides all others
 	if client != nil && client.TransactionStarting() && client.CurrentRc != nil {
 		rc = client.CurrentRc
@@ -994,9 +1129,10 @@ func (op Operation) addClusterTime(dst []byte, desc description.SelectedServer)
 }
 
 // updateClusterTimes updates the cluster times for the session and cluster clock attached to this
-// operation. While the session's AdvanceClusterTime may return an error, this method does not
-// because an error being returned from this method will not be returned further up.
-func (op Operation) updateClusterTimes(response bsoncore.Document) {
+// operation and reports the $clusterTime received from the server at addr to the cluster clock's observer. While the
+// session's AdvanceClusterTime may return an error, this method does not because an error being returned from this
+// method will not be returned further up.
+func (op Operation) updateClusterTimes(response bsoncore.Document, addr address.Address) {
 	// Extract cluster time.
 	value, err := response.LookupErr("$clusterTime")
 	if err != nil {
@@ -1013,6 +1149,9 @@ func (op Operation) updateClusterTimes(response bsoncore.Document) {
 
 	if clock != nil {
 		clock.AdvanceClusterTime(bson.Raw(clusterTime))
+		if doc, ok := value.DocumentOK(); ok {
+			clock.ObserveClusterTime(addr, bson.Raw(doc))
+		}
 	}
 }
 
@@ -1337,11 +1476,12 @@ func (op Operation) publishStartedEvent(ctx context.Context, info startedInforma
 	}
 
 	started := &event.CommandStartedEvent{
-		Command:      cmdCopy,
-		DatabaseName: op.Database,
-		CommandName:  info.cmdName,
-		RequestID:    int64(info.requestID),
-		ConnectionID: info.connID,
+		Command:       cmdCopy,
+		DatabaseName:  op.Database,
+		CommandName:   info.cmdName,
+		RequestID:     int64(info.requestID),
+		ConnectionID:  info.connID,
+		OperationName: internal.OperationName(ctx),
 	}
 	op.CommandMonitor.Started(ctx, started)
 }
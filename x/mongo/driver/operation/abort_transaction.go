@@ -11,6 +11,7 @@ package operation
 import (
 	"context"
 	"errors"
+	"time"
 
 	"go.mongodb.org/mongo-driver/event"
 	"go.mongodb.org/mongo-driver/mongo/description"
@@ -31,8 +32,10 @@ type AbortTransaction struct {
 	database      string
 	deployment    driver.Deployment
 	selector      description.ServerSelector
+	timeout       *time.Duration
 	writeConcern  *writeconcern.WriteConcern
 	retry         *driver.RetryMode
+	retryTimeout  *time.Duration
 }
 
 // NewAbortTransaction constructs and returns a new AbortTransaction.
@@ -56,6 +59,7 @@ func (at *AbortTransaction) Execute(ctx context.Context) error {
 		ProcessResponseFn: at.processResponse,
 		RetryMode:         at.retry,
 		Type:              driver.Write,
+		RetryTimeout:      at.retryTimeout,
 		Client:            at.session,
 		Clock:             at.clock,
 		CommandMonitor:    at.monitor,
@@ -63,6 +67,7 @@ func (at *AbortTransaction) Execute(ctx context.Context) error {
 		Database:          at.database,
 		Deployment:        at.deployment,
 		Selector:          at.selector,
+		Timeout:           at.timeout,
 		WriteConcern:      at.writeConcern,
 	}.Execute(ctx, nil)
 
@@ -167,6 +172,16 @@ func (at *AbortTransaction) ServerSelector(selector description.ServerSelector)
 	return at
 }
 
+// Timeout sets the timeout for this operation.
+func (at *AbortTransaction) Timeout(timeout *time.Duration) *AbortTransaction {
+	if at == nil {
+		at = new(AbortTransaction)
+	}
+
+	at.timeout = timeout
+	return at
+}
+
 // WriteConcern sets the write concern for this operation.
 func (at *AbortTransaction) WriteConcern(writeConcern *writeconcern.WriteConcern) *AbortTransaction {
 	if at == nil {
@@ -187,3 +202,13 @@ func (at *AbortTransaction) Retry(retry driver.RetryMode) *AbortTransaction {
 	at.retry = &retry
 	return at
 }
+
+// RetryTimeout sets the amount of time after the first attempt during which retries can be attempted.
+func (at *AbortTransaction) RetryTimeout(retryTimeout *time.Duration) *AbortTransaction {
+	if at == nil {
+		at = new(AbortTransaction)
+	}
+
+	at.retryTimeout = retryTimeout
+	return at
+}
@@ -11,6 +11,7 @@ package operation
 import (
 	"context"
 	"errors"
+	"time"
 
 	"go.mongodb.org/mongo-driver/bson/bsontype"
 	"go.mongodb.org/mongo-driver/event"
@@ -29,8 +30,9 @@ type Aggregate struct {
 	batchSize                *int32
 	bypassDocumentValidation *bool
 	collation                bsoncore.Document
-	comment                  *string
+	commentValue             bsoncore.Value
 	hint                     bsoncore.Value
+	let                      bsoncore.Document
 	maxTimeMS                *int64
 	pipeline                 bsoncore.Document
 	session                  *session.Client
@@ -43,6 +45,7 @@ type Aggregate struct {
 	readPreference           *readpref.ReadPref
 	retry                    *driver.RetryMode
 	selector                 description.ServerSelector
+	timeout                  *time.Duration
 	writeConcern             *writeconcern.WriteConcern
 	crypt                    *driver.Crypt
 
@@ -97,6 +100,7 @@ func (a *Aggregate) Execute(ctx context.Context) error {
 		Type:                           driver.Read,
 		RetryMode:                      a.retry,
 		Selector:                       a.selector,
+		Timeout:                        a.timeout,
 		WriteConcern:                   a.writeConcern,
 		Crypt:                          a.crypt,
 		MinimumWriteConcernWireVersion: 5,
@@ -130,14 +134,18 @@ func (a *Aggregate) command(dst []byte, desc description.SelectedServer) ([]byte
 		}
 		dst = bsoncore.AppendDocumentElement(dst, "collation", a.collation)
 	}
-	if a.comment != nil {
+	if a.commentValue.Type != bsontype.Type(0) {
 
-		dst = bsoncore.AppendStringElement(dst, "comment", *a.comment)
+		dst = bsoncore.AppendValueElement(dst, "comment", a.commentValue)
 	}
 	if a.hint.Type != bsontype.Type(0) {
 
 		dst = bsoncore.AppendValueElement(dst, "hint", a.hint)
 	}
+	if a.let != nil {
+
+		dst = bsoncore.AppendDocumentElement(dst, "let", a.let)
+	}
 	if a.maxTimeMS != nil {
 
 		dst = bsoncore.AppendInt64Element(dst, "maxTimeMS", *a.maxTimeMS)
@@ -192,13 +200,14 @@ func (a *Aggregate) Collation(collation bsoncore.Document) *Aggregate {
 	return a
 }
 
-// Comment specifies an arbitrary string to help trace the operation through the database profiler, currentOp, and logs.
-func (a *Aggregate) Comment(comment string) *Aggregate {
+// CommentValue specifies an arbitrary BSON value to help trace the operation through the database profiler, currentOp,
+// and logs. It replaces any comment set by Comment.
+func (a *Aggregate) CommentValue(commentValue bsoncore.Value) *Aggregate {
 	if a == nil {
 		a = new(Aggregate)
 	}
 
-	a.comment = &comment
+	a.commentValue = commentValue
 	return a
 }
 
@@ -212,6 +221,16 @@ func (a *Aggregate) Hint(hint bsoncore.Value) *Aggregate {
 	return a
 }
 
+// Let specifies the let document containing variables that can be accessed in the pipeline. This option is only valid for server versions 5.0 and above.
+func (a *Aggregate) Let(let bsoncore.Document) *Aggregate {
+	if a == nil {
+		a = new(Aggregate)
+	}
+
+	a.let = let
+	return a
+}
+
 // MaxTimeMS specifies the maximum amount of time to allow the query to run.
 func (a *Aggregate) MaxTimeMS(maxTimeMS int64) *Aggregate {
 	if a == nil {
@@ -322,6 +341,16 @@ func (a *Aggregate) ServerSelector(selector description.ServerSelector) *Aggrega
 	return a
 }
 
+// Timeout sets the timeout for this operation.
+func (a *Aggregate) Timeout(timeout *time.Duration) *Aggregate {
+	if a == nil {
+		a = new(Aggregate)
+	}
+
+	a.timeout = timeout
+	return a
+}
+
 // WriteConcern sets the write concern for this operation.
 func (a *Aggregate) WriteConcern(writeConcern *writeconcern.WriteConcern) *Aggregate {
 	if a == nil {
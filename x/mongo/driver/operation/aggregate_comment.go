@@ -0,0 +1,19 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package operation
+
+import (
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
+)
+
+// Comment specifies an arbitrary string to help trace the operation through the database profiler, currentOp, and logs.
+// It replaces any comment set by CommentValue.
+func (a *Aggregate) Comment(comment string) *Aggregate {
+	// This is not generated because operationgen creates one setter for each request field.
+	return a.CommentValue(bsoncore.Value{Type: bsontype.String, Data: bsoncore.AppendString(nil, comment)})
+}
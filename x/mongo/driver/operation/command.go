@@ -5,6 +5,7 @@ package operation
 import (
 	"context"
 	"errors"
+	"time"
 
 	"go.mongodb.org/mongo-driver/event"
 	"go.mongodb.org/mongo-driver/mongo/description"
@@ -22,6 +23,7 @@ type Command struct {
 	database       string
 	deployment     driver.Deployment
 	selector       description.ServerSelector
+	timeout        *time.Duration
 	readPreference *readpref.ReadPref
 	clock          *session.ClusterClock
 	session        *session.Client
@@ -30,6 +32,7 @@ type Command struct {
 	srvr           driver.Server
 	desc           description.Server
 	crypt          *driver.Crypt
+	retry          *driver.RetryMode
 }
 
 // NewCommand constructs and returns a new Command.
@@ -38,6 +41,9 @@ func NewCommand(command bsoncore.Document) *Command { return &Command{command: c
 // Result returns the result of executing this operation.
 func (c *Command) Result() bsoncore.Document { return c.result }
 
+// ServerDescription returns the description of the server that executed this operation.
+func (c *Command) ServerDescription() description.Server { return c.desc }
+
 // ResultCursor parses the command response as a cursor and returns the resulting BatchCursor.
 func (c *Command) ResultCursor(opts driver.CursorOptions) (*driver.BatchCursor, error) {
 	cursorRes, err := driver.NewCursorResponse(c.result, c.srvr, c.desc)
@@ -54,6 +60,12 @@ func (c *Command) Execute(ctx context.Context) error {
 		return errors.New("the Command operation must have a Deployment set before Execute can be called")
 	}
 
+	// Commands are only treated as reads if they have been explicitly marked as retryable.
+	var opType driver.Type
+	if c.retry != nil {
+		opType = driver.Read
+	}
+
 	return driver.Operation{
 		CommandFn: func(dst []byte, desc description.SelectedServer) ([]byte, error) {
 			return append(dst, c.command[4:len(c.command)-1]...), nil
@@ -71,7 +83,10 @@ func (c *Command) Execute(ctx context.Context) error {
 		Deployment:     c.deployment,
 		ReadPreference: c.readPreference,
 		Selector:       c.selector,
+		Timeout:        c.timeout,
 		Crypt:          c.crypt,
+		RetryMode:      c.retry,
+		Type:           opType,
 	}.Execute(ctx, nil)
 }
 
@@ -165,6 +180,16 @@ func (c *Command) ServerSelector(selector description.ServerSelector) *Command {
 	return c
 }
 
+// Timeout sets the timeout for this operation.
+func (c *Command) Timeout(timeout *time.Duration) *Command {
+	if c == nil {
+		c = new(Command)
+	}
+
+	c.timeout = timeout
+	return c
+}
+
 // Crypt sets the Crypt object to use for automatic encryption and decryption.
 func (c *Command) Crypt(crypt *driver.Crypt) *Command {
 	if c == nil {
@@ -174,3 +199,14 @@ func (c *Command) Crypt(crypt *driver.Crypt) *Command {
 	c.crypt = crypt
 	return c
 }
+
+// Retry enables retryable mode for this operation. The command will be retried as a read, so this should only be used
+// for commands that are idempotent.
+func (c *Command) Retry(retry driver.RetryMode) *Command {
+	if c == nil {
+		c = new(Command)
+	}
+
+	c.retry = &retry
+	return c
+}
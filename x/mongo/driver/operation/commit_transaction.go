@@ -11,6 +11,7 @@ package operation
 import (
 	"context"
 	"errors"
+	"time"
 
 	"go.mongodb.org/mongo-driver/event"
 	"go.mongodb.org/mongo-driver/mongo/description"
@@ -31,8 +32,10 @@ type CommitTransaction struct {
 	database      string
 	deployment    driver.Deployment
 	selector      description.ServerSelector
+	timeout       *time.Duration
 	writeConcern  *writeconcern.WriteConcern
 	retry         *driver.RetryMode
+	retryTimeout  *time.Duration
 }
 
 // NewCommitTransaction constructs and returns a new CommitTransaction.
@@ -56,6 +59,7 @@ func (ct *CommitTransaction) Execute(ctx context.Context) error {
 		ProcessResponseFn: ct.processResponse,
 		RetryMode:         ct.retry,
 		Type:              driver.Write,
+		RetryTimeout:      ct.retryTimeout,
 		Client:            ct.session,
 		Clock:             ct.clock,
 		CommandMonitor:    ct.monitor,
@@ -63,6 +67,7 @@ func (ct *CommitTransaction) Execute(ctx context.Context) error {
 		Database:          ct.database,
 		Deployment:        ct.deployment,
 		Selector:          ct.selector,
+		Timeout:           ct.timeout,
 		WriteConcern:      ct.writeConcern,
 	}.Execute(ctx, nil)
 
@@ -170,6 +175,16 @@ func (ct *CommitTransaction) ServerSelector(selector description.ServerSelector)
 	return ct
 }
 
+// Timeout sets the timeout for this operation.
+func (ct *CommitTransaction) Timeout(timeout *time.Duration) *CommitTransaction {
+	if ct == nil {
+		ct = new(CommitTransaction)
+	}
+
+	ct.timeout = timeout
+	return ct
+}
+
 // WriteConcern sets the write concern for this operation.
 func (ct *CommitTransaction) WriteConcern(writeConcern *writeconcern.WriteConcern) *CommitTransaction {
 	if ct == nil {
@@ -190,3 +205,13 @@ func (ct *CommitTransaction) Retry(retry driver.RetryMode) *CommitTransaction {
 	ct.retry = &retry
 	return ct
 }
+
+// RetryTimeout sets the amount of time after the first attempt during which retries can be attempted.
+func (ct *CommitTransaction) RetryTimeout(retryTimeout *time.Duration) *CommitTransaction {
+	if ct == nil {
+		ct = new(CommitTransaction)
+	}
+
+	ct.retryTimeout = retryTimeout
+	return ct
+}
@@ -12,6 +12,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"go.mongodb.org/mongo-driver/event"
 	"go.mongodb.org/mongo-driver/mongo/description"
@@ -36,6 +37,7 @@ type Count struct {
 	readConcern    *readconcern.ReadConcern
 	readPreference *readpref.ReadPref
 	selector       description.ServerSelector
+	timeout        *time.Duration
 	retry          *driver.RetryMode
 	result         CountResult
 }
@@ -98,6 +100,7 @@ func (c *Count) Execute(ctx context.Context) error {
 		ReadConcern:       c.readConcern,
 		ReadPreference:    c.readPreference,
 		Selector:          c.selector,
+		Timeout:           c.timeout,
 	}.Execute(ctx, nil)
 
 }
@@ -233,6 +236,16 @@ func (c *Count) ServerSelector(selector description.ServerSelector) *Count {
 	return c
 }
 
+// Timeout sets the timeout for this operation.
+func (c *Count) Timeout(timeout *time.Duration) *Count {
+	if c == nil {
+		c = new(Count)
+	}
+
+	c.timeout = timeout
+	return c
+}
+
 // Retry enables retryable mode for this operation. Retries are handled automatically in driver.Operation.Execute based
 // on how the operation is set.
 func (c *Count) Retry(retry driver.RetryMode) *Count {
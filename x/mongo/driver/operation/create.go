@@ -11,6 +11,7 @@ package operation
 import (
 	"context"
 	"errors"
+	"time"
 
 	"go.mongodb.org/mongo-driver/event"
 	"go.mongodb.org/mongo-driver/mongo/description"
@@ -23,8 +24,10 @@ import (
 // Create a create operation
 type Create struct {
 	capped              *bool
+	clusteredIndex      bsoncore.Document
 	collation           bsoncore.Document
 	collectionName      *string
+	encryptedFields     bsoncore.Document
 	indexOptionDefaults bsoncore.Document
 	max                 *int64
 	pipeline            bsoncore.Document
@@ -41,6 +44,7 @@ type Create struct {
 	database            string
 	deployment          driver.Deployment
 	selector            description.ServerSelector
+	timeout             *time.Duration
 	writeConcern        *writeconcern.WriteConcern
 }
 
@@ -72,6 +76,7 @@ func (c *Create) Execute(ctx context.Context) error {
 		Database:          c.database,
 		Deployment:        c.deployment,
 		Selector:          c.selector,
+		Timeout:           c.timeout,
 		WriteConcern:      c.writeConcern,
 	}.Execute(ctx, nil)
 
@@ -84,12 +89,18 @@ func (c *Create) command(dst []byte, desc description.SelectedServer) ([]byte, e
 	if c.capped != nil {
 		dst = bsoncore.AppendBooleanElement(dst, "capped", *c.capped)
 	}
+	if c.clusteredIndex != nil {
+		dst = bsoncore.AppendDocumentElement(dst, "clusteredIndex", c.clusteredIndex)
+	}
 	if c.collation != nil {
 		if desc.WireVersion == nil || !desc.WireVersion.Includes(5) {
 			return nil, errors.New("the 'collation' command parameter requires a minimum server wire version of 5")
 		}
 		dst = bsoncore.AppendDocumentElement(dst, "collation", c.collation)
 	}
+	if c.encryptedFields != nil {
+		dst = bsoncore.AppendDocumentElement(dst, "encryptedFields", c.encryptedFields)
+	}
 	if c.indexOptionDefaults != nil {
 		dst = bsoncore.AppendDocumentElement(dst, "indexOptionDefaults", c.indexOptionDefaults)
 	}
@@ -130,6 +141,16 @@ func (c *Create) Capped(capped bool) *Create {
 	return c
 }
 
+// Specifies the clustered index of the collection. This option is only valid for server versions 5.3 and above.
+func (c *Create) ClusteredIndex(clusteredIndex bsoncore.Document) *Create {
+	if c == nil {
+		c = new(Create)
+	}
+
+	c.clusteredIndex = clusteredIndex
+	return c
+}
+
 // Collation specifies a collation. This option is only valid for server versions 3.4 and above.
 func (c *Create) Collation(collation bsoncore.Document) *Create {
 	if c == nil {
@@ -150,6 +171,16 @@ func (c *Create) CollectionName(collectionName string) *Create {
 	return c
 }
 
+// Specifies the encrypted fields of a Queryable Encryption collection. This option is only valid for server versions 6.0 and above.
+func (c *Create) EncryptedFields(encryptedFields bsoncore.Document) *Create {
+	if c == nil {
+		c = new(Create)
+	}
+
+	c.encryptedFields = encryptedFields
+	return c
+}
+
 // Specifies a default configuration for indexes on the collection.
 func (c *Create) IndexOptionDefaults(indexOptionDefaults bsoncore.Document) *Create {
 	if c == nil {
@@ -310,6 +341,16 @@ func (c *Create) ServerSelector(selector description.ServerSelector) *Create {
 	return c
 }
 
+// Timeout sets the timeout for this operation.
+func (c *Create) Timeout(timeout *time.Duration) *Create {
+	if c == nil {
+		c = new(Create)
+	}
+
+	c.timeout = timeout
+	return c
+}
+
 // WriteConcern sets the write concern for this operation.
 func (c *Create) WriteConcern(writeConcern *writeconcern.WriteConcern) *Create {
 	if c == nil {
@@ -12,6 +12,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"go.mongodb.org/mongo-driver/bson/bsontype"
 	"go.mongodb.org/mongo-driver/event"
@@ -24,6 +25,7 @@ import (
 
 // CreateIndexes performs a createIndexes operation.
 type CreateIndexes struct {
+	comment      bsoncore.Value
 	commitQuorum bsoncore.Value
 	indexes      bsoncore.Document
 	maxTimeMS    *int64
@@ -35,6 +37,7 @@ type CreateIndexes struct {
 	database     string
 	deployment   driver.Deployment
 	selector     description.ServerSelector
+	timeout      *time.Duration
 	writeConcern *writeconcern.WriteConcern
 	result       CreateIndexesResult
 }
@@ -111,6 +114,7 @@ func (ci *CreateIndexes) Execute(ctx context.Context) error {
 		Database:          ci.database,
 		Deployment:        ci.deployment,
 		Selector:          ci.selector,
+		Timeout:           ci.timeout,
 		WriteConcern:      ci.writeConcern,
 	}.Execute(ctx, nil)
 
@@ -118,6 +122,9 @@ func (ci *CreateIndexes) Execute(ctx context.Context) error {
 
 func (ci *CreateIndexes) command(dst []byte, desc description.SelectedServer) ([]byte, error) {
 	dst = bsoncore.AppendStringElement(dst, "createIndexes", ci.collection)
+	if ci.comment.Type != bsontype.Type(0) {
+		dst = bsoncore.AppendValueElement(dst, "comment", ci.comment)
+	}
 	if ci.commitQuorum.Type != bsontype.Type(0) {
 		if desc.WireVersion == nil || !desc.WireVersion.Includes(9) {
 			return nil, errors.New("the 'commitQuorum' command parameter requires a minimum server wire version of 9")
@@ -133,6 +140,16 @@ func (ci *CreateIndexes) command(dst []byte, desc description.SelectedServer) ([
 	return dst, nil
 }
 
+// Comment specifies an arbitrary value to help trace the operation through the database profiler, currentOp, and logs.
+func (ci *CreateIndexes) Comment(comment bsoncore.Value) *CreateIndexes {
+	if ci == nil {
+		ci = new(CreateIndexes)
+	}
+
+	ci.comment = comment
+	return ci
+}
+
 // The number of data-bearing members of a replica set, including the primary, that must complete the index builds
 // successfully before the primary marks the indexes as ready. This should either be a string or int32 value.
 //
@@ -245,6 +262,16 @@ func (ci *CreateIndexes) ServerSelector(selector description.ServerSelector) *Cr
 	return ci
 }
 
+// Timeout sets the timeout for this operation.
+func (ci *CreateIndexes) Timeout(timeout *time.Duration) *CreateIndexes {
+	if ci == nil {
+		ci = new(CreateIndexes)
+	}
+
+	ci.timeout = timeout
+	return ci
+}
+
 // WriteConcern sets the write concern for this operation.
 func (ci *CreateIndexes) WriteConcern(writeConcern *writeconcern.WriteConcern) *CreateIndexes {
 	if ci == nil {
@@ -12,6 +12,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"go.mongodb.org/mongo-driver/event"
 	"go.mongodb.org/mongo-driver/mongo/description"
@@ -33,8 +34,10 @@ type Delete struct {
 	database     string
 	deployment   driver.Deployment
 	selector     description.ServerSelector
+	timeout      *time.Duration
 	writeConcern *writeconcern.WriteConcern
 	retry        *driver.RetryMode
+	retryTimeout *time.Duration
 	hint         *bool
 	result       DeleteResult
 }
@@ -96,6 +99,7 @@ func (d *Delete) Execute(ctx context.Context) error {
 		Batches:           batches,
 		RetryMode:         d.retry,
 		Type:              driver.Write,
+		RetryTimeout:      d.retryTimeout,
 		Client:            d.session,
 		Clock:             d.clock,
 		CommandMonitor:    d.monitor,
@@ -103,6 +107,7 @@ func (d *Delete) Execute(ctx context.Context) error {
 		Database:          d.database,
 		Deployment:        d.deployment,
 		Selector:          d.selector,
+		Timeout:           d.timeout,
 		WriteConcern:      d.writeConcern,
 	}.Execute(ctx, nil)
 
@@ -227,6 +232,16 @@ func (d *Delete) ServerSelector(selector description.ServerSelector) *Delete {
 	return d
 }
 
+// Timeout sets the timeout for this operation.
+func (d *Delete) Timeout(timeout *time.Duration) *Delete {
+	if d == nil {
+		d = new(Delete)
+	}
+
+	d.timeout = timeout
+	return d
+}
+
 // WriteConcern sets the write concern for this operation.
 func (d *Delete) WriteConcern(writeConcern *writeconcern.WriteConcern) *Delete {
 	if d == nil {
@@ -259,3 +274,13 @@ func (d *Delete) Hint(hint bool) *Delete {
 	d.hint = &hint
 	return d
 }
+
+// RetryTimeout sets the amount of time after the first attempt during which retries can be attempted.
+func (d *Delete) RetryTimeout(retryTimeout *time.Duration) *Delete {
+	if d == nil {
+		d = new(Delete)
+	}
+
+	d.retryTimeout = retryTimeout
+	return d
+}
@@ -11,7 +11,9 @@ package operation
 import (
 	"context"
 	"errors"
+	"time"
 
+	"go.mongodb.org/mongo-driver/bson/bsontype"
 	"go.mongodb.org/mongo-driver/event"
 	"go.mongodb.org/mongo-driver/mongo/description"
 	"go.mongodb.org/mongo-driver/mongo/readconcern"
@@ -24,6 +26,7 @@ import (
 // Distinct performs a distinct operation.
 type Distinct struct {
 	collation      bsoncore.Document
+	hint           bsoncore.Value
 	key            *string
 	maxTimeMS      *int64
 	query          bsoncore.Document
@@ -37,6 +40,7 @@ type Distinct struct {
 	readConcern    *readconcern.ReadConcern
 	readPreference *readpref.ReadPref
 	selector       description.ServerSelector
+	timeout        *time.Duration
 	retry          *driver.RetryMode
 	result         DistinctResult
 }
@@ -98,6 +102,7 @@ func (d *Distinct) Execute(ctx context.Context) error {
 		ReadConcern:       d.readConcern,
 		ReadPreference:    d.readPreference,
 		Selector:          d.selector,
+		Timeout:           d.timeout,
 	}.Execute(ctx, nil)
 
 }
@@ -110,6 +115,9 @@ func (d *Distinct) command(dst []byte, desc description.SelectedServer) ([]byte,
 		}
 		dst = bsoncore.AppendDocumentElement(dst, "collation", d.collation)
 	}
+	if d.hint.Type != bsontype.Type(0) {
+		dst = bsoncore.AppendValueElement(dst, "hint", d.hint)
+	}
 	if d.key != nil {
 		dst = bsoncore.AppendStringElement(dst, "key", *d.key)
 	}
@@ -132,6 +140,16 @@ func (d *Distinct) Collation(collation bsoncore.Document) *Distinct {
 	return d
 }
 
+// Hint specifies the index to use. This option is only valid for server versions 7.1 and above.
+func (d *Distinct) Hint(hint bsoncore.Value) *Distinct {
+	if d == nil {
+		d = new(Distinct)
+	}
+
+	d.hint = hint
+	return d
+}
+
 // Key specifies which field to return distinct values for.
 func (d *Distinct) Key(key string) *Distinct {
 	if d == nil {
@@ -262,6 +280,16 @@ func (d *Distinct) ServerSelector(selector description.ServerSelector) *Distinct
 	return d
 }
 
+// Timeout sets the timeout for this operation.
+func (d *Distinct) Timeout(timeout *time.Duration) *Distinct {
+	if d == nil {
+		d = new(Distinct)
+	}
+
+	d.timeout = timeout
+	return d
+}
+
 // Retry enables retryable mode for this operation. Retries are handled automatically in driver.Operation.Execute based
 // on how the operation is set.
 func (d *Distinct) Retry(retry driver.RetryMode) *Distinct {
@@ -12,6 +12,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"go.mongodb.org/mongo-driver/event"
 	"go.mongodb.org/mongo-driver/mongo/description"
@@ -31,6 +32,7 @@ type DropCollection struct {
 	database     string
 	deployment   driver.Deployment
 	selector     description.ServerSelector
+	timeout      *time.Duration
 	writeConcern *writeconcern.WriteConcern
 	result       DropCollectionResult
 }
@@ -97,6 +99,7 @@ func (dc *DropCollection) Execute(ctx context.Context) error {
 		Database:          dc.database,
 		Deployment:        dc.deployment,
 		Selector:          dc.selector,
+		Timeout:           dc.timeout,
 		WriteConcern:      dc.writeConcern,
 	}.Execute(ctx, nil)
 
@@ -187,6 +190,16 @@ func (dc *DropCollection) ServerSelector(selector description.ServerSelector) *D
 	return dc
 }
 
+// Timeout sets the timeout for this operation.
+func (dc *DropCollection) Timeout(timeout *time.Duration) *DropCollection {
+	if dc == nil {
+		dc = new(DropCollection)
+	}
+
+	dc.timeout = timeout
+	return dc
+}
+
 // WriteConcern sets the write concern for this operation.
 func (dc *DropCollection) WriteConcern(writeConcern *writeconcern.WriteConcern) *DropCollection {
 	if dc == nil {
@@ -12,7 +12,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
+	"go.mongodb.org/mongo-driver/bson/bsontype"
 	"go.mongodb.org/mongo-driver/event"
 	"go.mongodb.org/mongo-driver/mongo/description"
 	"go.mongodb.org/mongo-driver/mongo/writeconcern"
@@ -23,6 +25,7 @@ import (
 
 // DropIndexes performs an dropIndexes operation.
 type DropIndexes struct {
+	comment      bsoncore.Value
 	index        *string
 	maxTimeMS    *int64
 	session      *session.Client
@@ -33,6 +36,7 @@ type DropIndexes struct {
 	database     string
 	deployment   driver.Deployment
 	selector     description.ServerSelector
+	timeout      *time.Duration
 	writeConcern *writeconcern.WriteConcern
 	result       DropIndexesResult
 }
@@ -93,6 +97,7 @@ func (di *DropIndexes) Execute(ctx context.Context) error {
 		Database:          di.database,
 		Deployment:        di.deployment,
 		Selector:          di.selector,
+		Timeout:           di.timeout,
 		WriteConcern:      di.writeConcern,
 	}.Execute(ctx, nil)
 
@@ -100,6 +105,9 @@ func (di *DropIndexes) Execute(ctx context.Context) error {
 
 func (di *DropIndexes) command(dst []byte, desc description.SelectedServer) ([]byte, error) {
 	dst = bsoncore.AppendStringElement(dst, "dropIndexes", di.collection)
+	if di.comment.Type != bsontype.Type(0) {
+		dst = bsoncore.AppendValueElement(dst, "comment", di.comment)
+	}
 	if di.index != nil {
 		dst = bsoncore.AppendStringElement(dst, "index", *di.index)
 	}
@@ -109,6 +117,16 @@ func (di *DropIndexes) command(dst []byte, desc description.SelectedServer) ([]b
 	return dst, nil
 }
 
+// Comment specifies an arbitrary value to help trace the operation through the database profiler, currentOp, and logs.
+func (di *DropIndexes) Comment(comment bsoncore.Value) *DropIndexes {
+	if di == nil {
+		di = new(DropIndexes)
+	}
+
+	di.comment = comment
+	return di
+}
+
 // Index specifies the name of the index to drop. If '*' is specified, all indexes will be dropped.
 //
 func (di *DropIndexes) Index(index string) *DropIndexes {
@@ -210,6 +228,16 @@ func (di *DropIndexes) ServerSelector(selector description.ServerSelector) *Drop
 	return di
 }
 
+// Timeout sets the timeout for this operation.
+func (di *DropIndexes) Timeout(timeout *time.Duration) *DropIndexes {
+	if di == nil {
+		di = new(DropIndexes)
+	}
+
+	di.timeout = timeout
+	return di
+}
+
 // WriteConcern sets the write concern for this operation.
 func (di *DropIndexes) WriteConcern(writeConcern *writeconcern.WriteConcern) *DropIndexes {
 	if di == nil {
@@ -11,6 +11,7 @@ package operation
 import (
 	"context"
 	"errors"
+	"time"
 
 	"go.mongodb.org/mongo-driver/event"
 	"go.mongodb.org/mongo-driver/mongo/description"
@@ -29,6 +30,7 @@ type EndSessions struct {
 	database   string
 	deployment driver.Deployment
 	selector   description.ServerSelector
+	timeout    *time.Duration
 }
 
 // NewEndSessions constructs and returns a new EndSessions.
@@ -59,6 +61,7 @@ func (es *EndSessions) Execute(ctx context.Context) error {
 		Database:          es.database,
 		Deployment:        es.deployment,
 		Selector:          es.selector,
+		Timeout:           es.timeout,
 	}.Execute(ctx, nil)
 
 }
@@ -149,3 +152,13 @@ func (es *EndSessions) ServerSelector(selector description.ServerSelector) *EndS
 	es.selector = selector
 	return es
 }
+
+// Timeout sets the timeout for this operation.
+func (es *EndSessions) Timeout(timeout *time.Duration) *EndSessions {
+	if es == nil {
+		es = new(EndSessions)
+	}
+
+	es.timeout = timeout
+	return es
+}
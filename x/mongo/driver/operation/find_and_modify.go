@@ -12,6 +12,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/bsontype"
@@ -43,8 +44,10 @@ type FindAndModify struct {
 	database                 string
 	deployment               driver.Deployment
 	selector                 description.ServerSelector
+	timeout                  *time.Duration
 	writeConcern             *writeconcern.WriteConcern
 	retry                    *driver.RetryMode
+	retryTimeout             *time.Duration
 	crypt                    *driver.Crypt
 	hint                     bsoncore.Value
 
@@ -127,12 +130,14 @@ func (fam *FindAndModify) Execute(ctx context.Context) error {
 
 		RetryMode:      fam.retry,
 		Type:           driver.Write,
+		RetryTimeout:   fam.retryTimeout,
 		Client:         fam.session,
 		Clock:          fam.clock,
 		CommandMonitor: fam.monitor,
 		Database:       fam.database,
 		Deployment:     fam.deployment,
 		Selector:       fam.selector,
+		Timeout:        fam.timeout,
 		WriteConcern:   fam.writeConcern,
 		Crypt:          fam.crypt,
 	}.Execute(ctx, nil)
@@ -385,6 +390,16 @@ func (fam *FindAndModify) ServerSelector(selector description.ServerSelector) *F
 	return fam
 }
 
+// Timeout sets the timeout for this operation.
+func (fam *FindAndModify) Timeout(timeout *time.Duration) *FindAndModify {
+	if fam == nil {
+		fam = new(FindAndModify)
+	}
+
+	fam.timeout = timeout
+	return fam
+}
+
 // WriteConcern sets the write concern for this operation.
 func (fam *FindAndModify) WriteConcern(writeConcern *writeconcern.WriteConcern) *FindAndModify {
 	if fam == nil {
@@ -426,3 +441,13 @@ func (fam *FindAndModify) Hint(hint bsoncore.Value) *FindAndModify {
 	fam.hint = hint
 	return fam
 }
+
+// RetryTimeout sets the amount of time after the first attempt during which retries can be attempted.
+func (fam *FindAndModify) RetryTimeout(retryTimeout *time.Duration) *FindAndModify {
+	if fam == nil {
+		fam = new(FindAndModify)
+	}
+
+	fam.retryTimeout = retryTimeout
+	return fam
+}
@@ -12,6 +12,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"go.mongodb.org/mongo-driver/event"
 	"go.mongodb.org/mongo-driver/mongo/description"
@@ -24,6 +25,7 @@ import (
 // Insert performs an insert operation.
 type Insert struct {
 	bypassDocumentValidation *bool
+	bypassEmptyTsReplacement *bool
 	documents                []bsoncore.Document
 	ordered                  *bool
 	session                  *session.Client
@@ -34,8 +36,11 @@ type Insert struct {
 	database                 string
 	deployment               driver.Deployment
 	selector                 description.ServerSelector
+	timeout                  *time.Duration
 	writeConcern             *writeconcern.WriteConcern
 	retry                    *driver.RetryMode
+	retryTimeout             *time.Duration
+	skipDocumentSizeCheck    bool
 	result                   InsertResult
 }
 
@@ -91,19 +96,22 @@ func (i *Insert) Execute(ctx context.Context) error {
 	}
 
 	return driver.Operation{
-		CommandFn:         i.command,
-		ProcessResponseFn: i.processResponse,
-		Batches:           batches,
-		RetryMode:         i.retry,
-		Type:              driver.Write,
-		Client:            i.session,
-		Clock:             i.clock,
-		CommandMonitor:    i.monitor,
-		Crypt:             i.crypt,
-		Database:          i.database,
-		Deployment:        i.deployment,
-		Selector:          i.selector,
-		WriteConcern:      i.writeConcern,
+		CommandFn:                  i.command,
+		ProcessResponseFn:          i.processResponse,
+		Batches:                    batches,
+		SkipDocumentSizeValidation: i.skipDocumentSizeCheck,
+		RetryMode:                  i.retry,
+		Type:                       driver.Write,
+		RetryTimeout:               i.retryTimeout,
+		Client:                     i.session,
+		Clock:                      i.clock,
+		CommandMonitor:             i.monitor,
+		Crypt:                      i.crypt,
+		Database:                   i.database,
+		Deployment:                 i.deployment,
+		Selector:                   i.selector,
+		Timeout:                    i.timeout,
+		WriteConcern:               i.writeConcern,
 	}.Execute(ctx, nil)
 
 }
@@ -113,6 +121,9 @@ func (i *Insert) command(dst []byte, desc description.SelectedServer) ([]byte, e
 	if i.bypassDocumentValidation != nil && (desc.WireVersion != nil && desc.WireVersion.Includes(4)) {
 		dst = bsoncore.AppendBooleanElement(dst, "bypassDocumentValidation", *i.bypassDocumentValidation)
 	}
+	if i.bypassEmptyTsReplacement != nil && (desc.WireVersion != nil && desc.WireVersion.Includes(25)) {
+		dst = bsoncore.AppendBooleanElement(dst, "bypassEmptyTsReplacement", *i.bypassEmptyTsReplacement)
+	}
 	if i.ordered != nil {
 		dst = bsoncore.AppendBooleanElement(dst, "ordered", *i.ordered)
 	}
@@ -130,6 +141,18 @@ func (i *Insert) BypassDocumentValidation(bypassDocumentValidation bool) *Insert
 	return i
 }
 
+// BypassEmptyTsReplacement prevents the server from replacing empty Timestamp values with the
+// current cluster time when inserting documents. Valid for server versions >= 8.0. For servers
+// < 8.0, this setting is ignored.
+func (i *Insert) BypassEmptyTsReplacement(bypassEmptyTsReplacement bool) *Insert {
+	if i == nil {
+		i = new(Insert)
+	}
+
+	i.bypassEmptyTsReplacement = &bypassEmptyTsReplacement
+	return i
+}
+
 // Documents adds documents to this operation that will be inserted when this operation is
 // executed.
 func (i *Insert) Documents(documents ...bsoncore.Document) *Insert {
@@ -232,6 +255,16 @@ func (i *Insert) ServerSelector(selector description.ServerSelector) *Insert {
 	return i
 }
 
+// Timeout sets the timeout for this operation.
+func (i *Insert) Timeout(timeout *time.Duration) *Insert {
+	if i == nil {
+		i = new(Insert)
+	}
+
+	i.timeout = timeout
+	return i
+}
+
 // WriteConcern sets the write concern for this operation.
 func (i *Insert) WriteConcern(writeConcern *writeconcern.WriteConcern) *Insert {
 	if i == nil {
@@ -252,3 +285,24 @@ func (i *Insert) Retry(retry driver.RetryMode) *Insert {
 	i.retry = &retry
 	return i
 }
+
+// RetryTimeout sets the amount of time after the first attempt during which retries can be attempted.
+func (i *Insert) RetryTimeout(retryTimeout *time.Duration) *Insert {
+	if i == nil {
+		i = new(Insert)
+	}
+
+	i.retryTimeout = retryTimeout
+	return i
+}
+
+// SkipDocumentSizeValidation disables the client-side check that each document is no larger than the maximum document
+// size of the selected server.
+func (i *Insert) SkipDocumentSizeValidation(skip bool) *Insert {
+	if i == nil {
+		i = new(Insert)
+	}
+
+	i.skipDocumentSizeCheck = skip
+	return i
+}
@@ -11,6 +11,7 @@ package operation
 import (
 	"context"
 	"errors"
+	"time"
 
 	"go.mongodb.org/mongo-driver/event"
 	"go.mongodb.org/mongo-driver/mongo/description"
@@ -32,6 +33,7 @@ type ListCollections struct {
 	deployment     driver.Deployment
 	readPreference *readpref.ReadPref
 	selector       description.ServerSelector
+	timeout        *time.Duration
 	retry          *driver.RetryMode
 	result         driver.CursorResponse
 	batchSize      *int32
@@ -82,6 +84,7 @@ func (lc *ListCollections) Execute(ctx context.Context) error {
 		Deployment:        lc.deployment,
 		ReadPreference:    lc.readPreference,
 		Selector:          lc.selector,
+		Timeout:           lc.timeout,
 		Legacy:            driver.LegacyListCollections,
 	}.Execute(ctx, nil)
 
@@ -205,6 +208,16 @@ func (lc *ListCollections) ServerSelector(selector description.ServerSelector) *
 	return lc
 }
 
+// Timeout sets the timeout for this operation.
+func (lc *ListCollections) Timeout(timeout *time.Duration) *ListCollections {
+	if lc == nil {
+		lc = new(ListCollections)
+	}
+
+	lc.timeout = timeout
+	return lc
+}
+
 // Retry enables retryable mode for this operation. Retries are handled automatically in driver.Operation.Execute based
 // on how the operation is set.
 func (lc *ListCollections) Retry(retry driver.RetryMode) *ListCollections {
@@ -11,6 +11,7 @@ package operation
 import (
 	"context"
 	"errors"
+	"time"
 
 	"go.mongodb.org/mongo-driver/event"
 	"go.mongodb.org/mongo-driver/mongo/description"
@@ -22,6 +23,7 @@ import (
 // ListIndexes performs a listIndexes operation.
 type ListIndexes struct {
 	batchSize  *int32
+	comment    *string
 	maxTimeMS  *int64
 	session    *session.Client
 	clock      *session.ClusterClock
@@ -30,6 +32,7 @@ type ListIndexes struct {
 	database   string
 	deployment driver.Deployment
 	selector   description.ServerSelector
+	timeout    *time.Duration
 	retry      *driver.RetryMode
 	crypt      *driver.Crypt
 
@@ -74,6 +77,7 @@ func (li *ListIndexes) Execute(ctx context.Context) error {
 		Database:       li.database,
 		Deployment:     li.deployment,
 		Selector:       li.selector,
+		Timeout:        li.timeout,
 		Crypt:          li.crypt,
 		Legacy:         driver.LegacyListIndexes,
 		RetryMode:      li.retry,
@@ -90,6 +94,10 @@ func (li *ListIndexes) command(dst []byte, desc description.SelectedServer) ([]b
 
 		cursorDoc = bsoncore.AppendInt32Element(cursorDoc, "batchSize", *li.batchSize)
 	}
+	if li.comment != nil {
+
+		dst = bsoncore.AppendStringElement(dst, "comment", *li.comment)
+	}
 	if li.maxTimeMS != nil {
 
 		dst = bsoncore.AppendInt64Element(dst, "maxTimeMS", *li.maxTimeMS)
@@ -110,6 +118,16 @@ func (li *ListIndexes) BatchSize(batchSize int32) *ListIndexes {
 	return li
 }
 
+// Comment sets a string to help trace an operation.
+func (li *ListIndexes) Comment(comment string) *ListIndexes {
+	if li == nil {
+		li = new(ListIndexes)
+	}
+
+	li.comment = &comment
+	return li
+}
+
 // MaxTimeMS specifies the maximum amount of time to allow the query to run.
 func (li *ListIndexes) MaxTimeMS(maxTimeMS int64) *ListIndexes {
 	if li == nil {
@@ -190,6 +208,16 @@ func (li *ListIndexes) ServerSelector(selector description.ServerSelector) *List
 	return li
 }
 
+// Timeout sets the timeout for this operation.
+func (li *ListIndexes) Timeout(timeout *time.Duration) *ListIndexes {
+	if li == nil {
+		li = new(ListIndexes)
+	}
+
+	li.timeout = timeout
+	return li
+}
+
 // Retry enables retryable mode for this operation. Retries are handled automatically in driver.Operation.Execute based
 // on how the operation is set.
 func (li *ListIndexes) Retry(retry driver.RetryMode) *ListIndexes {
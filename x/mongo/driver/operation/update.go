@@ -12,6 +12,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/event"
@@ -36,8 +37,10 @@ type Update struct {
 	hint                     *bool
 	arrayFilters             *bool
 	selector                 description.ServerSelector
+	timeout                  *time.Duration
 	writeConcern             *writeconcern.WriteConcern
 	retry                    *driver.RetryMode
+	retryTimeout             *time.Duration
 	result                   UpdateResult
 	crypt                    *driver.Crypt
 }
@@ -146,12 +149,14 @@ func (u *Update) Execute(ctx context.Context) error {
 		Batches:           batches,
 		RetryMode:         u.retry,
 		Type:              driver.Write,
+		RetryTimeout:      u.retryTimeout,
 		Client:            u.session,
 		Clock:             u.clock,
 		CommandMonitor:    u.monitor,
 		Database:          u.database,
 		Deployment:        u.deployment,
 		Selector:          u.selector,
+		Timeout:           u.timeout,
 		WriteConcern:      u.writeConcern,
 		Crypt:             u.crypt,
 	}.Execute(ctx, nil)
@@ -314,6 +319,16 @@ func (u *Update) ServerSelector(selector description.ServerSelector) *Update {
 	return u
 }
 
+// Timeout sets the timeout for this operation.
+func (u *Update) Timeout(timeout *time.Duration) *Update {
+	if u == nil {
+		u = new(Update)
+	}
+
+	u.timeout = timeout
+	return u
+}
+
 // WriteConcern sets the write concern for this operation.
 func (u *Update) WriteConcern(writeConcern *writeconcern.WriteConcern) *Update {
 	if u == nil {
@@ -345,3 +360,13 @@ func (u *Update) Crypt(crypt *driver.Crypt) *Update {
 	u.crypt = crypt
 	return u
 }
+
+// RetryTimeout sets the amount of time after the first attempt during which retries can be attempted.
+func (u *Update) RetryTimeout(retryTimeout *time.Duration) *Update {
+	if u == nil {
+		u = new(Update)
+	}
+
+	u.retryTimeout = retryTimeout
+	return u
+}
@@ -8,8 +8,11 @@ import (
 	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/bsontype"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/event"
+	"go.mongodb.org/mongo-driver/internal"
 	"go.mongodb.org/mongo-driver/internal/testutil/assert"
 	"go.mongodb.org/mongo-driver/mongo/address"
 	"go.mongodb.org/mongo-driver/mongo/description"
@@ -17,6 +20,7 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/readpref"
 	"go.mongodb.org/mongo-driver/mongo/writeconcern"
 	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
+	"go.mongodb.org/mongo-driver/x/mongo/driver/drivertest"
 	"go.mongodb.org/mongo-driver/x/mongo/driver/session"
 	"go.mongodb.org/mongo-driver/x/mongo/driver/uuid"
 	"go.mongodb.org/mongo-driver/x/mongo/driver/wiremessage"
@@ -231,6 +235,32 @@ func TestOperation(t *testing.T) {
 			}
 		}
 	})
+	t.Run("addReadConcern in transaction", func(t *testing.T) {
+		snapshotRc := bsoncore.AppendDocumentElement(nil, "readConcern", bsoncore.BuildDocument(nil,
+			bsoncore.AppendStringElement(nil, "level", "snapshot"),
+		))
+
+		id, err := uuid.New()
+		noerr(t, err)
+		sess, err := session.NewClientSession(session.NewPool(nil), id, session.Explicit)
+		noerr(t, err)
+		err = sess.StartTransaction(&session.TransactionOptions{ReadConcern: readconcern.Snapshot()})
+		noerr(t, err)
+
+		op := Operation{Client: sess, ReadConcern: readconcern.Majority()}
+		got, err := op.addReadConcern(nil, description.SelectedServer{})
+		noerr(t, err)
+		if !bytes.Equal(got, snapshotRc) {
+			t.Errorf("ReadConcern elements do not match for first command. got %v; want %v", got, snapshotRc)
+		}
+
+		sess.ApplyCommand(description.Server{})
+		got, err = op.addReadConcern(nil, description.SelectedServer{})
+		noerr(t, err)
+		if got != nil {
+			t.Errorf("expected no read concern for subsequent command, got %v", got)
+		}
+	})
 	t.Run("addWriteConcern", func(t *testing.T) {
 		want := bsoncore.AppendDocumentElement(nil, "writeConcern", bsoncore.BuildDocumentFromElements(
 			nil, bsoncore.AppendStringElement(nil, "w", "majority"),
@@ -241,6 +271,278 @@ func TestOperation(t *testing.T) {
 			t.Errorf("WriteConcern elements do not match. got %v; want %v", got, want)
 		}
 	})
+	t.Run("unordered batches collect all write errors", func(t *testing.T) {
+		reply := bsoncore.BuildDocumentFromElements(nil,
+			bsoncore.AppendInt32Element(nil, "ok", 1),
+			bsoncore.AppendInt32Element(nil, "n", 0),
+			bsoncore.AppendArrayElement(nil, "writeErrors", bsoncore.BuildArray(nil,
+				bsoncore.Value{Type: bsontype.EmbeddedDocument, Data: bsoncore.BuildDocumentFromElements(nil,
+					bsoncore.AppendInt32Element(nil, "index", 0),
+					bsoncore.AppendInt32Element(nil, "code", 11000),
+					bsoncore.AppendStringElement(nil, "errmsg", "duplicate key"),
+				)},
+			)),
+		)
+		conn := &mockConnection{
+			rReadWM: drivertest.MakeReply(reply),
+			rDesc: description.Server{
+				WireVersion:     &description.VersionRange{Max: 5},
+				MaxBatchCount:   1,
+				MaxDocumentSize: 16 * 1024 * 1024,
+				MaxMessageSize:  48 * 1024 * 1024,
+			},
+		}
+		id, err := uuid.New()
+		noerr(t, err)
+		sess, err := session.NewClientSession(session.NewPool(nil), id, session.Implicit)
+		noerr(t, err)
+		ordered := false
+		op := Operation{
+			Database:   "foobar",
+			Deployment: SingleConnectionDeployment{C: conn},
+			Client:     sess,
+			CommandFn: func(dst []byte, desc description.SelectedServer) ([]byte, error) {
+				return bsoncore.AppendStringElement(dst, "insert", "coll"), nil
+			},
+			Batches: &Batches{
+				Identifier: "documents",
+				Documents: []bsoncore.Document{
+					bsoncore.BuildDocumentFromElements(nil, bsoncore.AppendInt32Element(nil, "_id", 1)),
+					bsoncore.BuildDocumentFromElements(nil, bsoncore.AppendInt32Element(nil, "_id", 2)),
+				},
+				Ordered: &ordered,
+			},
+			Type: Write,
+		}
+
+		err = op.Execute(context.Background(), nil)
+		wce, ok := err.(WriteCommandError)
+		if !ok {
+			t.Fatalf("expected error of type %T, got %v", WriteCommandError{}, err)
+		}
+		if len(wce.WriteErrors) != 2 {
+			t.Fatalf("expected 2 write errors, got %v", wce.WriteErrors)
+		}
+		for i, we := range wce.WriteErrors {
+			if we.Index != int64(i) {
+				t.Errorf("expected write error %d to have index %d, got %d", i, i, we.Index)
+			}
+		}
+	})
+	t.Run("write concern errors and labels are kept across batches", func(t *testing.T) {
+		first := bsoncore.BuildDocumentFromElements(nil,
+			bsoncore.AppendInt32Element(nil, "ok", 1),
+			bsoncore.AppendInt32Element(nil, "n", 1),
+			bsoncore.AppendDocumentElement(nil, "writeConcernError", bsoncore.BuildDocumentFromElements(nil,
+				bsoncore.AppendInt32Element(nil, "code", 64),
+				bsoncore.AppendStringElement(nil, "errmsg", "waiting for replication timed out"),
+			)),
+			bsoncore.AppendArrayElement(nil, "errorLabels", bsoncore.BuildArray(nil,
+				bsoncore.Value{Type: bsontype.String, Data: bsoncore.AppendString(nil, "foo")},
+			)),
+		)
+		duplicateKey := bsoncore.BuildDocumentFromElements(nil,
+			bsoncore.AppendInt32Element(nil, "ok", 1),
+			bsoncore.AppendInt32Element(nil, "n", 0),
+			bsoncore.AppendArrayElement(nil, "writeErrors", bsoncore.BuildArray(nil,
+				bsoncore.Value{Type: bsontype.EmbeddedDocument, Data: bsoncore.BuildDocumentFromElements(nil,
+					bsoncore.AppendInt32Element(nil, "index", 0),
+					bsoncore.AppendInt32Element(nil, "code", 11000),
+					bsoncore.AppendStringElement(nil, "errmsg", "duplicate key"),
+				)},
+			)),
+		)
+		clean := bsoncore.BuildDocumentFromElements(nil,
+			bsoncore.AppendInt32Element(nil, "ok", 1),
+			bsoncore.AppendInt32Element(nil, "n", 1),
+		)
+
+		testCases := []struct {
+			name           string
+			second         bsoncore.Document
+			numWriteErrors int
+		}{
+			{"clean second batch", clean, 0},
+			{"second batch with write errors", duplicateKey, 1},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				conn := &sequenceConnection{
+					mockConnection: &mockConnection{
+						rDesc: description.Server{
+							WireVersion:     &description.VersionRange{Max: 5},
+							MaxBatchCount:   1,
+							MaxDocumentSize: 16 * 1024 * 1024,
+							MaxMessageSize:  48 * 1024 * 1024,
+						},
+					},
+					replies: [][]byte{drivertest.MakeReply(first), drivertest.MakeReply(tc.second)},
+				}
+				id, err := uuid.New()
+				noerr(t, err)
+				sess, err := session.NewClientSession(session.NewPool(nil), id, session.Implicit)
+				noerr(t, err)
+				ordered := false
+				op := Operation{
+					Database:   "foobar",
+					Deployment: SingleConnectionDeployment{C: conn},
+					Client:     sess,
+					CommandFn: func(dst []byte, desc description.SelectedServer) ([]byte, error) {
+						return bsoncore.AppendStringElement(dst, "insert", "coll"), nil
+					},
+					Batches: &Batches{
+						Identifier: "documents",
+						Documents: []bsoncore.Document{
+							bsoncore.BuildDocumentFromElements(nil, bsoncore.AppendInt32Element(nil, "_id", 1)),
+							bsoncore.BuildDocumentFromElements(nil, bsoncore.AppendInt32Element(nil, "_id", 2)),
+						},
+						Ordered: &ordered,
+					},
+					Type: Write,
+				}
+
+				err = op.Execute(context.Background(), nil)
+				wce, ok := err.(WriteCommandError)
+				if !ok {
+					t.Fatalf("expected error of type %T, got %v", WriteCommandError{}, err)
+				}
+				if wce.WriteConcernError == nil || wce.WriteConcernError.Code != 64 {
+					t.Errorf("expected the write concern error from the first batch, got %v", wce.WriteConcernError)
+				}
+				if !wce.HasErrorLabel("foo") {
+					t.Errorf("expected the labels from the first batch, got %v", wce.Labels)
+				}
+				if len(wce.WriteErrors) != tc.numWriteErrors {
+					t.Errorf("expected %d write errors, got %v", tc.numWriteErrors, wce.WriteErrors)
+				}
+			})
+		}
+	})
+	t.Run("oversized documents do not use a transaction number", func(t *testing.T) {
+		conn := &mockConnection{
+			rDesc: description.Server{
+				WireVersion:           &description.VersionRange{Min: 0, Max: 8},
+				SessionTimeoutMinutes: 30,
+				Kind:                  description.RSPrimary,
+				MaxDocumentSize:       10,
+			},
+		}
+		id, err := uuid.New()
+		noerr(t, err)
+		sess, err := session.NewClientSession(session.NewPool(nil), id, session.Implicit)
+		noerr(t, err)
+		txnNumber := sess.Server.TxnNumber
+
+		retry := RetryOnce
+		op := Operation{
+			Database:   "foobar",
+			Deployment: SingleConnectionDeployment{C: conn},
+			Client:     sess,
+			CommandFn: func(dst []byte, desc description.SelectedServer) ([]byte, error) {
+				return bsoncore.AppendStringElement(dst, "insert", "coll"), nil
+			},
+			Batches: &Batches{
+				Identifier: "documents",
+				Documents:  []bsoncore.Document{make(bsoncore.Document, 20)},
+			},
+			RetryMode: &retry,
+			Type:      Write,
+		}
+		err = op.Execute(context.Background(), nil)
+		if _, ok := err.(DocumentTooLargeError); !ok {
+			t.Fatalf("expected error of type %T, got %v", DocumentTooLargeError{}, err)
+		}
+		if sess.Server.TxnNumber != txnNumber {
+			t.Errorf("expected transaction number %d, got %d", txnNumber, sess.Server.TxnNumber)
+		}
+	})
+	t.Run("rejected command does not change the transaction state", func(t *testing.T) {
+		conn := &mockConnection{
+			rDesc: description.Server{
+				WireVersion:           &description.VersionRange{Min: 0, Max: 7},
+				SessionTimeoutMinutes: 30,
+				Kind:                  description.RSPrimary,
+			},
+		}
+		id, err := uuid.New()
+		noerr(t, err)
+		sess, err := session.NewClientSession(session.NewPool(nil), id, session.Explicit)
+		noerr(t, err)
+		err = sess.StartTransaction(&session.TransactionOptions{})
+		noerr(t, err)
+
+		errRejected := errors.New("rejected")
+		op := Operation{
+			Database: "foobar",
+			Deployment: rejectingDeployment{
+				SingleConnectionDeployment: SingleConnectionDeployment{C: conn},
+				err:                        errRejected,
+			},
+			Client: sess,
+			CommandFn: func(dst []byte, desc description.SelectedServer) ([]byte, error) {
+				return bsoncore.AppendStringElement(dst, "insert", "coll"), nil
+			},
+			Type: Write,
+		}
+		err = op.Execute(context.Background(), nil)
+		if err != errRejected {
+			t.Fatalf("expected error %v, got %v", errRejected, err)
+		}
+		if !sess.TransactionStarting() {
+			t.Errorf("expected the transaction to still be starting")
+		}
+		if conn.pWriteWM != nil {
+			t.Errorf("expected no wire message to be written")
+		}
+	})
+	t.Run("addMaxTimeMS", func(t *testing.T) {
+		timeout := 10 * time.Second
+		deadlineCtx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+		expiredCtx, expiredCancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+		defer expiredCancel()
+
+		t.Run("no timeout", func(t *testing.T) {
+			got, err := Operation{}.addMaxTimeMS(deadlineCtx, nil, nil)
+			noerr(t, err)
+			if len(got) != 0 {
+				t.Errorf("expected no maxTimeMS to be added, got %v", got)
+			}
+		})
+		t.Run("no deadline", func(t *testing.T) {
+			got, err := Operation{Timeout: &timeout}.addMaxTimeMS(context.Background(), nil, nil)
+			noerr(t, err)
+			if len(got) != 0 {
+				t.Errorf("expected no maxTimeMS to be added, got %v", got)
+			}
+		})
+		t.Run("derived from deadline", func(t *testing.T) {
+			got, err := Operation{Timeout: &timeout}.addMaxTimeMS(deadlineCtx, nil, nil)
+			noerr(t, err)
+			elem, _, ok := bsoncore.ReadElement(got)
+			if !ok || elem.Key() != "maxTimeMS" {
+				t.Fatalf("expected maxTimeMS element, got %v", got)
+			}
+			ms := elem.Value().Int64()
+			if ms <= 0 || ms > int64(timeout/time.Millisecond) {
+				t.Errorf("maxTimeMS out of range. got %v; want (0, %v]", ms, int64(timeout/time.Millisecond))
+			}
+		})
+		t.Run("existing maxTimeMS is preserved", func(t *testing.T) {
+			elems := bsoncore.AppendInt64Element(nil, "maxTimeMS", 500)
+			got, err := Operation{Timeout: &timeout}.addMaxTimeMS(deadlineCtx, elems, elems)
+			noerr(t, err)
+			if !bytes.Equal(got, elems) {
+				t.Errorf("expected command to be unchanged. got %v; want %v", got, elems)
+			}
+		})
+		t.Run("expired deadline", func(t *testing.T) {
+			_, err := Operation{Timeout: &timeout}.addMaxTimeMS(expiredCtx, nil, nil)
+			if err != context.DeadlineExceeded {
+				t.Errorf("expected error %v, got %v", context.DeadlineExceeded, err)
+			}
+		})
+	})
 	t.Run("addSession", func(t *testing.T) { t.Skip("These tests should be covered by spec tests.") })
 	t.Run("addClusterTime", func(t *testing.T) {
 		t.Run("adds max cluster time", func(t *testing.T) {
@@ -287,7 +589,12 @@ func TestOperation(t *testing.T) {
 
 		sess, err := session.NewClientSession(sessPool, id, session.Explicit)
 		noerr(t, err)
-		Operation{Client: sess, Clock: clusterClock}.updateClusterTimes(clustertime)
+		var observedAddr address.Address
+		var observed bson.Raw
+		clusterClock.SetObserver(func(addr address.Address, ct bson.Raw) {
+			observedAddr, observed = addr, ct
+		})
+		Operation{Client: sess, Clock: clusterClock}.updateClusterTimes(clustertime, address.Address("localhost:27017"))
 
 		got := sess.ClusterTime
 		if !bytes.Equal(got, clustertime) {
@@ -297,8 +604,15 @@ func TestOperation(t *testing.T) {
 		if !bytes.Equal(got, clustertime) {
 			t.Errorf("ClusterTimes do not match. got %v; want %v", got, clustertime)
 		}
+		if observedAddr != "localhost:27017" {
+			t.Errorf("observed address does not match. got %v; want %v", observedAddr, "localhost:27017")
+		}
+		want := bson.Raw(bsoncore.Document(clustertime).Lookup("$clusterTime").Document())
+		if !bytes.Equal(observed, want) {
+			t.Errorf("observed ClusterTime does not match. got %v; want %v", observed, want)
+		}
 
-		Operation{}.updateClusterTimes(bsoncore.BuildDocumentFromElements(nil)) // should do nothing
+		Operation{}.updateClusterTimes(bsoncore.BuildDocumentFromElements(nil), "") // should do nothing
 	})
 	t.Run("updateOperationTime", func(t *testing.T) {
 		want := primitive.Timestamp{T: 1234, I: 4567}
@@ -516,6 +830,116 @@ func TestOperation(t *testing.T) {
 			})
 		}
 	})
+	t.Run("operation name", func(t *testing.T) {
+		serverResponseDoc := bsoncore.BuildDocumentFromElements(nil, bsoncore.AppendInt32Element(nil, "ok", 1))
+		conn := &mockConnection{
+			rDesc:   description.Server{WireVersion: &description.VersionRange{Max: 6}},
+			rReadWM: createExhaustServerResponse(t, serverResponseDoc, false),
+		}
+		var started *event.CommandStartedEvent
+		op := Operation{
+			CommandFn: func(dst []byte, desc description.SelectedServer) ([]byte, error) {
+				return bsoncore.AppendInt32Element(dst, "ping", 1), nil
+			},
+			Database:   "admin",
+			Deployment: SingleConnectionDeployment{conn},
+			CommandMonitor: &event.CommandMonitor{
+				Started: func(_ context.Context, evt *event.CommandStartedEvent) {
+					started = evt
+				},
+			},
+		}
+		err := op.Execute(internal.WithOperationName(context.Background(), "CreateOrder"), nil)
+		assert.Nil(t, err, "Execute error: %v", err)
+		assert.NotNil(t, started, "expected CommandStartedEvent to be published")
+		assert.Equal(t, "CreateOrder", started.OperationName, "expected operation name %q, got %q", "CreateOrder",
+			started.OperationName)
+	})
+	t.Run("retry timeout", func(t *testing.T) {
+		sess, err := session.NewClientSession(session.NewPool(nil), uuid.UUID{}, session.Explicit)
+		noerr(t, err)
+		desc := description.Server{
+			Kind:                  description.RSPrimary,
+			WireVersion:           &description.VersionRange{Max: 8},
+			SessionTimeoutMinutes: 1,
+		}
+		retryOnce := RetryOnce
+		zero := time.Duration(0)
+		testCases := []struct {
+			name         string
+			retryTimeout *time.Duration
+			attempts     int
+		}{
+			{"no retry timeout", nil, 2},
+			{"retry timeout elapsed", &zero, 1},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				conn := &countingConnection{mockConnection: &mockConnection{
+					rDesc:     desc,
+					rWriteErr: errors.New("write error"),
+				}}
+				op := Operation{
+					CommandFn: func(dst []byte, desc description.SelectedServer) ([]byte, error) {
+						return bsoncore.AppendInt32Element(dst, "insert", 1), nil
+					},
+					Database:     "admin",
+					Deployment:   SingleConnectionDeployment{conn},
+					Client:       sess,
+					Clock:        new(session.ClusterClock),
+					WriteConcern: writeconcern.New(writeconcern.WMajority()),
+					Type:         Write,
+					RetryMode:    &retryOnce,
+					RetryTimeout: tc.retryTimeout,
+				}
+				err := op.Execute(context.Background(), nil)
+				assert.NotNil(t, err, "expected Execute error, got nil")
+				assert.Equal(t, tc.attempts, conn.writes, "expected %v attempts, got %v", tc.attempts, conn.writes)
+			})
+		}
+		t.Run("restarts for each batch", func(t *testing.T) {
+			// The retry of the first batch replies after the retry timeout, so the second batch can only be retried
+			// if the timeout starts again for it.
+			batchDesc := desc
+			batchDesc.MaxBatchCount = 1
+			batchDesc.MaxDocumentSize = 16 * 1024 * 1024
+			batchDesc.MaxMessageSize = 48 * 1024 * 1024
+			ok := bsoncore.BuildDocumentFromElements(nil, bsoncore.AppendInt32Element(nil, "ok", 1))
+			conn := &flakyConnection{
+				mockConnection: &mockConnection{
+					rDesc:   batchDesc,
+					rReadWM: createExhaustServerResponse(t, ok, false),
+				},
+				fail:  map[int]bool{1: true, 3: true},
+				delay: 50 * time.Millisecond,
+			}
+			retryOncePerCommand := RetryOncePerCommand
+			retryTimeout := 20 * time.Millisecond
+			op := Operation{
+				CommandFn: func(dst []byte, desc description.SelectedServer) ([]byte, error) {
+					return bsoncore.AppendStringElement(dst, "insert", "coll"), nil
+				},
+				Batches: &Batches{
+					Identifier: "documents",
+					Documents: []bsoncore.Document{
+						bsoncore.BuildDocumentFromElements(nil, bsoncore.AppendInt32Element(nil, "_id", 1)),
+						bsoncore.BuildDocumentFromElements(nil, bsoncore.AppendInt32Element(nil, "_id", 2)),
+					},
+				},
+				Database:     "admin",
+				Deployment:   SingleConnectionDeployment{conn},
+				Client:       sess,
+				Clock:        new(session.ClusterClock),
+				WriteConcern: writeconcern.New(writeconcern.WMajority()),
+				Type:         Write,
+				RetryMode:    &retryOncePerCommand,
+				RetryTimeout: &retryTimeout,
+			}
+			err := op.Execute(context.Background(), nil)
+			assert.Nil(t, err, "Execute error: %v", err)
+			assert.Equal(t, 4, conn.writes, "expected 4 attempts, got %v", conn.writes)
+		})
+	})
 	t.Run("ExecuteExhaust", func(t *testing.T) {
 		t.Run("errors if connection is not streaming", func(t *testing.T) {
 			conn := &mockConnection{
@@ -661,6 +1085,65 @@ func (m *mockConnection) WriteWireMessage(_ context.Context, wm []byte) error {
 	return m.rWriteErr
 }
 
+// countingConnection is a mockConnection that records the number of wire messages written to it.
+type countingConnection struct {
+	*mockConnection
+	writes int
+}
+
+func (c *countingConnection) WriteWireMessage(ctx context.Context, wm []byte) error {
+	c.writes++
+	return c.mockConnection.WriteWireMessage(ctx, wm)
+}
+
+// flakyConnection is a mockConnection that fails the writes whose numbers are in fail and waits for delay before
+// each read.
+type flakyConnection struct {
+	*mockConnection
+	writes int
+	fail   map[int]bool
+	delay  time.Duration
+}
+
+func (c *flakyConnection) WriteWireMessage(ctx context.Context, wm []byte) error {
+	c.writes++
+	if c.fail[c.writes] {
+		return errors.New("write error")
+	}
+	return c.mockConnection.WriteWireMessage(ctx, wm)
+}
+
+func (c *flakyConnection) ReadWireMessage(ctx context.Context, dst []byte) ([]byte, error) {
+	time.Sleep(c.delay)
+	return c.mockConnection.ReadWireMessage(ctx, dst)
+}
+
+// rejectingDeployment is a SingleConnectionDeployment that rejects every command with err.
+type rejectingDeployment struct {
+	SingleConnectionDeployment
+	err error
+}
+
+func (rd rejectingDeployment) ValidateCommand(bsoncore.Document) error {
+	return rd.err
+}
+
+// sequenceConnection is a mockConnection that returns each of its replies in turn.
+type sequenceConnection struct {
+	*mockConnection
+	replies [][]byte
+}
+
+func (c *sequenceConnection) ReadWireMessage(_ context.Context, dst []byte) ([]byte, error) {
+	c.pReadDst = dst
+	if len(c.replies) == 0 {
+		return nil, errors.New("no replies left")
+	}
+	reply := c.replies[0]
+	c.replies = c.replies[1:]
+	return reply, nil
+}
+
 func (m *mockConnection) ReadWireMessage(_ context.Context, dst []byte) ([]byte, error) {
 	m.pReadDst = dst
 	return m.rReadWM, m.rReadErr
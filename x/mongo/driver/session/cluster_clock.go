@@ -10,11 +10,13 @@ import (
 	"sync"
 
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/address"
 )
 
 // ClusterClock represents a logical clock for keeping track of cluster time.
 type ClusterClock struct {
 	clusterTime bson.Raw
+	observer    func(address.Address, bson.Raw)
 	lock        sync.Mutex
 }
 
@@ -34,3 +36,22 @@ func (cc *ClusterClock) AdvanceClusterTime(clusterTime bson.Raw) {
 	cc.clusterTime = MaxClusterTime(cc.clusterTime, clusterTime)
 	cc.lock.Unlock()
 }
+
+// SetObserver sets a function to be called by ObserveClusterTime. Passing nil removes the observer.
+func (cc *ClusterClock) SetObserver(observer func(address.Address, bson.Raw)) {
+	cc.lock.Lock()
+	cc.observer = observer
+	cc.lock.Unlock()
+}
+
+// ObserveClusterTime calls the observer set by SetObserver, if any, with the address of the server that sent
+// clusterTime. The observer is called without holding the clock's lock.
+func (cc *ClusterClock) ObserveClusterTime(addr address.Address, clusterTime bson.Raw) {
+	cc.lock.Lock()
+	observer := cc.observer
+	cc.lock.Unlock()
+
+	if observer != nil {
+		observer(addr, clusterTime)
+	}
+}
@@ -174,6 +174,38 @@ func TestCMAPProse(t *testing.T) {
 					event.ReasonIdle, evt.Reason)
 			})
 		})
+		t.Run("maintenance", func(t *testing.T) {
+			t.Run("idle connection closed without checkouts", func(t *testing.T) {
+				// If a connection sits in the pool for longer than MaxIdleTime, the background maintenance routine
+				// should close it and publish an event even if no other connections are checked out.
+				clearEvents()
+
+				var dialer DialerFunc = func(context.Context, string, string) (net.Conn, error) {
+					return &testNetConn{}, nil
+				}
+				cfg := getConfig()
+				cfg.MaxIdleTime = 100 * time.Millisecond
+				pool := createTestPool(t, cfg, WithDialer(func(Dialer) Dialer { return dialer }))
+				defer func() {
+					_ = pool.disconnect(context.Background())
+				}()
+
+				conn, err := pool.get(context.Background())
+				assert.Nil(t, err, "get error: %v", err)
+				err = pool.put(conn)
+				assert.Nil(t, err, "put error: %v", err)
+
+				// The opened map can't be inspected here because the background routine may be modifying it.
+				assert.Equal(t, 1, len(created), "expected 1 creation event, got %d", len(created))
+				select {
+				case evt := <-closed:
+					assert.Equal(t, event.ReasonIdle, evt.Reason, "expected reason %q, got %q",
+						event.ReasonIdle, evt.Reason)
+				case <-time.After(2 * time.Second):
+					t.Fatal("timed out waiting for idle connection to be closed")
+				}
+			})
+		})
 		t.Run("disconnect", func(t *testing.T) {
 			t.Run("connections returned gracefully", func(t *testing.T) {
 				// If all connections are in the pool when disconnect is called, they should be closed gracefully and
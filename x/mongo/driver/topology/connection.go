@@ -60,6 +60,9 @@ type connection struct {
 	poolID       uint64
 	generation   uint64
 	expireReason string
+	createdAt    time.Time
+	checkedOut   int32        // must be accessed using the sync/atomic package
+	lastUsed     atomic.Value // Stores a time.Time
 }
 
 // newConnection handles the creation of a connection. It does not connect the connection.
@@ -442,6 +445,16 @@ func (c *connection) bumpIdleDeadline() {
 	}
 }
 
+// setCheckedOut records that the connection has been checked out of or returned to its pool.
+func (c *connection) setCheckedOut(checkedOut bool) {
+	var val int32
+	if checkedOut {
+		val = 1
+	}
+	atomic.StoreInt32(&c.checkedOut, val)
+	c.lastUsed.Store(time.Now())
+}
+
 func (c *connection) setCanStream(canStream bool) {
 	c.canStream = canStream
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/tls"
 	"net"
+	"net/url"
 	"time"
 
 	"go.mongodb.org/mongo-driver/event"
@@ -52,6 +53,7 @@ type connectionConfig struct {
 	disableOCSPEndpointCheck bool
 	errorHandlingCallback    func(error, uint64)
 	tlsConnectionSource      tlsConnectionSource
+	proxyURL                 *url.URL
 }
 
 func newConnectionConfig(opts ...ConnectionOption) (*connectionConfig, error) {
@@ -71,6 +73,9 @@ func newConnectionConfig(opts ...ConnectionOption) (*connectionConfig, error) {
 	if cfg.dialer == nil {
 		cfg.dialer = &net.Dialer{Timeout: cfg.connectTimeout}
 	}
+	if cfg.proxyURL != nil {
+		cfg.dialer = newProxyDialer(cfg.proxyURL, cfg.dialer)
+	}
 
 	return cfg, nil
 }
@@ -117,6 +122,16 @@ func WithDialer(fn func(Dialer) Dialer) ConnectionOption {
 	}
 }
 
+// WithProxyURL configures an HTTP proxy that connections will be tunneled through using the CONNECT method. The
+// proxy is used with the Dialer configured by WithDialer, and the TLS handshake, if any, happens after the tunnel is
+// established.
+func WithProxyURL(fn func(*url.URL) *url.URL) ConnectionOption {
+	return func(c *connectionConfig) error {
+		c.proxyURL = fn(c.proxyURL)
+		return nil
+	}
+}
+
 // WithHandshaker configures the Handshaker that wll be used to initialize newly
 // dialed connections.
 func WithHandshaker(fn func(Handshaker) Handshaker) ConnectionOption {
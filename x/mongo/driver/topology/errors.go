@@ -2,6 +2,7 @@ package topology
 
 import (
 	"fmt"
+	"strings"
 
 	"go.mongodb.org/mongo-driver/mongo/description"
 )
@@ -47,12 +48,30 @@ type ServerSelectionError struct {
 	Wrapped error
 }
 
-// Error implements the error interface.
+// Error implements the error interface. The message includes the address, kind, average round trip time, and last
+// error of each server in the topology so that selection failures can be diagnosed from the error alone.
 func (e ServerSelectionError) Error() string {
 	if e.Wrapped != nil {
-		return fmt.Sprintf("server selection error: %s, current topology: { %s }", e.Wrapped.Error(), e.Desc.String())
+		return fmt.Sprintf("server selection error: %s, current topology: { %s }", e.Wrapped.Error(), selectionDiagnostics(e.Desc))
+	}
+	return fmt.Sprintf("server selection error: current topology: { %s }", selectionDiagnostics(e.Desc))
+}
+
+// selectionDiagnostics formats a topology description for a server selection error.
+func selectionDiagnostics(desc description.Topology) string {
+	servers := make([]string, 0, len(desc.Servers))
+	for _, s := range desc.Servers {
+		rtt := "unknown"
+		if s.AverageRTTSet {
+			rtt = s.AverageRTT.String()
+		}
+		str := fmt.Sprintf("{ Addr: %s, Type: %s, Average RTT: %s", s.Addr, s.Kind, rtt)
+		if s.LastError != nil {
+			str += fmt.Sprintf(", Last error: %s", s.LastError)
+		}
+		servers = append(servers, str+" }")
 	}
-	return fmt.Sprintf("server selection error: current topology: { %s }", e.Desc.String())
+	return fmt.Sprintf("Type: %s, Servers: [%s]", desc.Kind, strings.Join(servers, ", "))
 }
 
 // Unwrap returns the underlying error.
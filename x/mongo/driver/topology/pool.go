@@ -9,6 +9,7 @@ package topology
 import (
 	"context"
 	"math"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -37,9 +38,14 @@ var ErrWaitQueueTimeout = PoolError("timed out while checking out a connection f
 // PoolError is an error returned from a Pool method.
 type PoolError string
 
-// maintainInterval is the interval at which the background routine to close stale connections will be run.
+// maintainInterval is the interval at which the background routine to close stale connections will be run. If the
+// pool's MaxIdleTime is shorter, the routine is run every MaxIdleTime instead so idle connections are closed promptly
+// even if no connections are checked out. The routine is never run more often than minMaintainInterval so a very short
+// MaxIdleTime does not keep it spinning.
 var maintainInterval = time.Minute
 
+const minMaintainInterval = 100 * time.Millisecond
+
 func (pe PoolError) Error() string { return string(pe) }
 
 // poolConfig contains all aspects of the pool that can be configured
@@ -70,7 +76,15 @@ type pool struct {
 	nextid    uint64
 	opened    map[uint64]*connection // opened holds all of the currently open connections.
 	sem       *semaphore.Weighted
+	semSize   int64
 	sync.Mutex
+
+	// The effective maximum pool size is lowered below semSize by holding reserved units of sem. Units that cannot be
+	// reserved immediately because connections are checked out are counted by pendingReserve and are reserved as the
+	// connections are returned.
+	resizeLock     sync.Mutex
+	reserved       int64
+	pendingReserve int64
 }
 
 // connectionExpiredFunc checks if a given connection is stale and should be removed from the resource pool
@@ -154,13 +168,22 @@ func newPool(config poolConfig, connOpts ...ConnectionOption) (*pool, error) {
 		opened:    make(map[uint64]*connection),
 		opts:      opts,
 		sem:       semaphore.NewWeighted(int64(maxConns)),
+		semSize:   int64(maxConns),
+	}
+
+	interval := maintainInterval
+	if config.MaxIdleTime > 0 && config.MaxIdleTime < interval {
+		interval = config.MaxIdleTime
+	}
+	if interval < minMaintainInterval {
+		interval = minMaintainInterval
 	}
 
 	// we do not pass in config.MaxPoolSize because we manage the max size at this level rather than the resource pool level
 	rpc := resourcePoolConfig{
 		MaxSize:          maxConns,
 		MinSize:          config.MinPoolSize,
-		MaintainInterval: maintainInterval,
+		MaintainInterval: interval,
 		ExpiredFn:        connectionExpiredFunc,
 		CloseFn:          connectionCloseFunc,
 		InitFn:           pool.connectionInitFunc,
@@ -187,6 +210,53 @@ func newPool(config poolConfig, connOpts ...ConnectionOption) (*pool, error) {
 	return pool, nil
 }
 
+// setMaxPoolSize changes the maximum number of connections that can be checked out of the pool at once. The size
+// cannot be raised above the MaxPoolSize the pool was created with, and a size of 0 resets the pool to that maximum.
+// If more connections are checked out than the new size allows, subsequent checkouts block until enough connections
+// have been returned to the pool.
+func (p *pool) setMaxPoolSize(maxSize uint64) {
+	var target int64
+	if maxSize != 0 && maxSize < uint64(p.semSize) {
+		target = p.semSize - int64(maxSize)
+	}
+
+	p.resizeLock.Lock()
+	defer p.resizeLock.Unlock()
+
+	p.pendingReserve = 0
+	if target < p.reserved {
+		p.sem.Release(p.reserved - target)
+		p.reserved = target
+	}
+	// Reserve in halving chunks so that an unbounded pool (semSize of math.MaxInt64) can be limited without acquiring
+	// the reservation one unit at a time.
+	for chunk := target - p.reserved; p.reserved < target && chunk > 0; {
+		if !p.sem.TryAcquire(chunk) {
+			chunk /= 2
+			continue
+		}
+		p.reserved += chunk
+		if remaining := target - p.reserved; remaining < chunk {
+			chunk = remaining
+		}
+	}
+	p.pendingReserve = target - p.reserved
+}
+
+// releaseSlot releases a checked out connection's unit of the semaphore, or keeps it as a reservation if the pool is
+// being shrunk.
+func (p *pool) releaseSlot() {
+	p.resizeLock.Lock()
+	defer p.resizeLock.Unlock()
+
+	if p.pendingReserve > 0 {
+		p.pendingReserve--
+		p.reserved++
+		return
+	}
+	p.sem.Release(1)
+}
+
 // stale checks if a given connection's generation is below the generation of the pool
 func (p *pool) stale(c *connection) bool {
 	return c == nil || c.generation < atomic.LoadUint64(&p.generation)
@@ -278,6 +348,7 @@ func (p *pool) makeNewConnection() (*connection, string, error) {
 	c.pool = p
 	c.poolID = atomic.AddUint64(&p.nextid, 1)
 	c.generation = atomic.LoadUint64(&p.generation)
+	c.createdAt = time.Now()
 
 	if p.monitor != nil {
 		p.monitor.Event(&event.PoolEvent{
@@ -310,6 +381,80 @@ func (p *pool) makeNewConnection() (*connection, string, error) {
 
 }
 
+// PoolStats is a snapshot of the connections in a connection pool.
+type PoolStats struct {
+	// InUse is the number of open connections that are checked out of the pool.
+	InUse int
+	// Available is the number of open connections that are idle in the pool.
+	Available int
+	// PendingConnections is the number of connections that have been created but have not finished connecting yet.
+	// Pending connections are also counted in InUse or Available.
+	PendingConnections int
+	// TotalCreated is the number of connections created by the pool since it was created.
+	TotalCreated uint64
+}
+
+// stats returns a snapshot of the connections in the pool.
+func (p *pool) stats() PoolStats {
+	p.Lock()
+	open := len(p.opened)
+	var pending int
+	for _, c := range p.opened {
+		if atomic.LoadInt32(&c.connected) == initialized {
+			pending++
+		}
+	}
+	p.Unlock()
+
+	available := int(atomic.LoadUint64(&p.conns.size))
+	inUse := open - available
+	if inUse < 0 {
+		inUse = 0
+	}
+	return PoolStats{
+		InUse:              inUse,
+		Available:          available,
+		PendingConnections: pending,
+		TotalCreated:       atomic.LoadUint64(&p.nextid),
+	}
+}
+
+// ConnectionStats is a snapshot of the state of a single connection in a connection pool.
+type ConnectionStats struct {
+	// ID is the pool-assigned ID of the connection. It matches the ConnectionID reported in PoolEvents.
+	ID uint64
+	// CreatedAt is the time at which the connection was created.
+	CreatedAt time.Time
+	// LastUsed is the time at which the connection was last checked out of or returned to the pool. It is the zero
+	// time if the connection has never been checked out.
+	LastUsed time.Time
+	// InUse is true if the connection is currently checked out of the pool.
+	InUse bool
+	// Generation is the pool generation the connection was created in. Connections from a generation older than the
+	// pool's current generation are closed when they are next checked in or out.
+	Generation uint64
+}
+
+// connectionStats returns a snapshot of each open connection in the pool, ordered by connection ID.
+func (p *pool) connectionStats() []ConnectionStats {
+	p.Lock()
+	stats := make([]ConnectionStats, 0, len(p.opened))
+	for _, c := range p.opened {
+		lastUsed, _ := c.lastUsed.Load().(time.Time)
+		stats = append(stats, ConnectionStats{
+			ID:         c.poolID,
+			CreatedAt:  c.createdAt,
+			LastUsed:   lastUsed,
+			InUse:      atomic.LoadInt32(&c.checkedOut) == 1,
+			Generation: c.generation,
+		})
+	}
+	p.Unlock()
+
+	sort.Slice(stats, func(i, j int) bool { return stats[i].ID < stats[j].ID })
+	return stats
+}
+
 func (p *pool) getGeneration() uint64 {
 	return atomic.LoadUint64(&p.generation)
 }
@@ -354,7 +499,7 @@ func (p *pool) get(ctx context.Context) (*connection, error) {
 					Reason:  event.ReasonPoolClosed,
 				})
 			}
-			p.sem.Release(1)
+			p.releaseSlot()
 			return nil, ErrPoolDisconnected
 		}
 
@@ -370,7 +515,7 @@ func (p *pool) get(ctx context.Context) (*connection, error) {
 				// Call removeConnection to remove the connection reference and emit a ConnectionClosed event.
 				_ = p.removeConnection(c, event.ReasonConnectionErrored)
 				p.conns.decrementTotal()
-				p.sem.Release(1)
+				p.releaseSlot()
 
 				if p.monitor != nil {
 					p.monitor.Event(&event.PoolEvent{
@@ -382,6 +527,7 @@ func (p *pool) get(ctx context.Context) (*connection, error) {
 				return nil, err
 			}
 
+			c.setCheckedOut(true)
 			if p.monitor != nil {
 				p.monitor.Event(&event.PoolEvent{
 					Type:         event.GetSucceeded,
@@ -401,7 +547,7 @@ func (p *pool) get(ctx context.Context) (*connection, error) {
 					Reason:  event.ReasonTimedOut,
 				})
 			}
-			p.sem.Release(1)
+			p.releaseSlot()
 			return nil, ctx.Err()
 		default:
 			// The pool is empty, so we try to make a new connection. If incrementTotal fails, the resource pool has
@@ -423,7 +569,7 @@ func (p *pool) get(ctx context.Context) (*connection, error) {
 					})
 				}
 				p.conns.decrementTotal()
-				p.sem.Release(1)
+				p.releaseSlot()
 				return nil, err
 			}
 
@@ -434,7 +580,7 @@ func (p *pool) get(ctx context.Context) (*connection, error) {
 				// Call removeConnection to remove the connection reference and fire a ConnectionClosedEvent.
 				_ = p.removeConnection(c, event.ReasonConnectionErrored)
 				p.conns.decrementTotal()
-				p.sem.Release(1)
+				p.releaseSlot()
 
 				if p.monitor != nil {
 					p.monitor.Event(&event.PoolEvent{
@@ -446,6 +592,7 @@ func (p *pool) get(ctx context.Context) (*connection, error) {
 				return nil, err
 			}
 
+			c.setCheckedOut(true)
 			if p.monitor != nil {
 				p.monitor.Event(&event.PoolEvent{
 					Type:         event.GetSucceeded,
@@ -513,7 +660,7 @@ func (p *pool) removeConnection(c *connection, reason string) error {
 // stale, and there is space in the cache, the connection is returned to the cache. This
 // assumes that the connection has already been counted in p.conns.totalSize.
 func (p *pool) put(c *connection) error {
-	defer p.sem.Release(1)
+	defer p.releaseSlot()
 	if p.monitor != nil {
 		var cid uint64
 		var addr string
@@ -536,6 +683,7 @@ func (p *pool) put(c *connection) error {
 		return ErrWrongPool
 	}
 
+	c.setCheckedOut(false)
 	_ = p.conns.Put(c)
 
 	return nil
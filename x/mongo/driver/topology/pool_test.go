@@ -28,6 +28,18 @@ func TestPool(t *testing.T) {
 				t.Errorf("Expected new pool to be connected. got %v; want %v", p.connected, connected)
 			}
 		})
+		t.Run("maintain interval has a minimum", func(t *testing.T) {
+			pc := poolConfig{
+				Address:     address.Address(""),
+				MaxIdleTime: time.Millisecond,
+			}
+			p, err := newPool(pc)
+			noerr(t, err)
+			if p.conns.maintainInterval != minMaintainInterval {
+				t.Errorf("Expected maintain interval to be clamped. got %v; want %v", p.conns.maintainInterval,
+					minMaintainInterval)
+			}
+		})
 	})
 	t.Run("closeConnection", func(t *testing.T) {
 		t.Run("can't put connection from different pool", func(t *testing.T) {
@@ -568,6 +580,54 @@ func TestPool(t *testing.T) {
 			}
 			close(cleanup)
 		})
+		t.Run("setMaxPoolSize limits checked out connections", func(t *testing.T) {
+			cleanup := make(chan struct{})
+			addr := bootstrapConnections(t, 3, func(nc net.Conn) {
+				<-cleanup
+				_ = nc.Close()
+			})
+			d := newdialer(&net.Dialer{})
+			pc := poolConfig{
+				Address:     address.Address(addr.String()),
+				MaxPoolSize: 3,
+			}
+			p, err := newPool(pc, WithDialer(func(Dialer) Dialer { return d }))
+			noerr(t, err)
+			err = p.connect()
+			noerr(t, err)
+
+			getWithTimeout := func() (*connection, error) {
+				ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+				defer cancel()
+				return p.get(ctx)
+			}
+
+			p.setMaxPoolSize(1)
+			c1, err := p.get(context.Background())
+			noerr(t, err)
+			if _, err = getWithTimeout(); err != ErrWaitQueueTimeout {
+				t.Errorf("Should time out with max pool size 1. got %v; want %v", err, ErrWaitQueueTimeout)
+			}
+
+			p.setMaxPoolSize(2)
+			c2, err := getWithTimeout()
+			noerr(t, err)
+
+			// Shrinking the pool while both connections are checked out takes effect once one is returned.
+			p.setMaxPoolSize(1)
+			err = p.put(c1)
+			noerr(t, err)
+			if _, err = getWithTimeout(); err != ErrWaitQueueTimeout {
+				t.Errorf("Should time out after shrinking pool. got %v; want %v", err, ErrWaitQueueTimeout)
+			}
+			err = p.put(c2)
+			noerr(t, err)
+			c3, err := getWithTimeout()
+			noerr(t, err)
+			err = p.put(c3)
+			noerr(t, err)
+			close(cleanup)
+		})
 		t.Run("handshaker i/o fails", func(t *testing.T) {
 			want := "unable to write wire message to network: Write error"
 
@@ -719,3 +779,79 @@ func assertConnectionsClosed(t *testing.T, dialer *dialer, expectedClosedCount i
 
 	assert.Soon(t, callback, 3*time.Second)
 }
+
+func TestPoolStats(t *testing.T) {
+	var dialer DialerFunc = func(context.Context, string, string) (net.Conn, error) {
+		return &testNetConn{}, nil
+	}
+	p, err := newPool(poolConfig{}, WithDialer(func(Dialer) Dialer { return dialer }))
+	noerr(t, err)
+	err = p.connect()
+	noerr(t, err)
+	defer func() {
+		_ = p.disconnect(context.Background())
+	}()
+
+	conns := make([]*connection, 0, 3)
+	for i := 0; i < 3; i++ {
+		c, err := p.get(context.Background())
+		noerr(t, err)
+		conns = append(conns, c)
+	}
+	err = p.put(conns[0])
+	noerr(t, err)
+
+	expected := PoolStats{InUse: 2, Available: 1, TotalCreated: 3}
+	stats := p.stats()
+	assert.Equal(t, expected, stats, "expected stats %+v, got %+v", expected, stats)
+}
+
+func TestPoolConnectionStats(t *testing.T) {
+	var dialer DialerFunc = func(context.Context, string, string) (net.Conn, error) {
+		return &testNetConn{}, nil
+	}
+	p, err := newPool(poolConfig{}, WithDialer(func(Dialer) Dialer { return dialer }))
+	noerr(t, err)
+	err = p.connect()
+	noerr(t, err)
+	defer func() {
+		_ = p.disconnect(context.Background())
+	}()
+
+	c1, err := p.get(context.Background())
+	noerr(t, err)
+	c2, err := p.get(context.Background())
+	noerr(t, err)
+	defer func() {
+		_ = p.put(c2)
+	}()
+	err = p.put(c1)
+	noerr(t, err)
+
+	stats := p.connectionStats()
+	assert.Equal(t, 2, len(stats), "expected 2 connections, got %v", len(stats))
+	for i, cs := range stats {
+		assert.Equal(t, uint64(i+1), cs.ID, "expected connection ID %v, got %v", i+1, cs.ID)
+		assert.False(t, cs.CreatedAt.IsZero(), "expected creation time to be set for connection %v", cs.ID)
+		assert.False(t, cs.LastUsed.Before(cs.CreatedAt), "expected last used time after creation time for connection %v",
+			cs.ID)
+		assert.Equal(t, uint64(0), cs.Generation, "expected generation 0, got %v", cs.Generation)
+	}
+	assert.False(t, stats[0].InUse, "expected returned connection to not be in use")
+	assert.True(t, stats[1].InUse, "expected checked out connection to be in use")
+
+	// The idle connection from the previous generation is closed when the next connection is checked out.
+	p.clear()
+	c3, err := p.get(context.Background())
+	noerr(t, err)
+	defer func() {
+		_ = p.put(c3)
+	}()
+
+	stats = p.connectionStats()
+	assert.Equal(t, 2, len(stats), "expected 2 connections, got %v", len(stats))
+	assert.Equal(t, uint64(2), stats[0].ID, "expected connection ID 2, got %v", stats[0].ID)
+	assert.Equal(t, uint64(0), stats[0].Generation, "expected generation 0, got %v", stats[0].Generation)
+	assert.Equal(t, uint64(3), stats[1].ID, "expected connection ID 3, got %v", stats[1].ID)
+	assert.Equal(t, uint64(1), stats[1].Generation, "expected generation 1, got %v", stats[1].Generation)
+}
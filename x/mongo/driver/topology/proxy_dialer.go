@@ -0,0 +1,100 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package topology
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// proxyDialer is a Dialer that tunnels TCP connections through an HTTP proxy using the CONNECT method. The tunnel is
+// established before any TLS handshake, so TLS is negotiated end-to-end with the server rather than with the proxy.
+type proxyDialer struct {
+	proxyURL *url.URL
+	dialer   Dialer
+}
+
+var _ Dialer = (*proxyDialer)(nil)
+
+// newProxyDialer creates a Dialer that connects to the proxy at proxyURL using dialer and then issues a CONNECT
+// request for the destination address.
+func newProxyDialer(proxyURL *url.URL, dialer Dialer) *proxyDialer {
+	return &proxyDialer{proxyURL: proxyURL, dialer: dialer}
+}
+
+// DialContext implements the Dialer interface. Connections over networks other than TCP (e.g. Unix domain sockets)
+// are not proxied.
+func (pd *proxyDialer) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	if network != "tcp" && network != "tcp4" && network != "tcp6" {
+		return pd.dialer.DialContext(ctx, network, address)
+	}
+
+	proxyAddr := pd.proxyURL.Host
+	if pd.proxyURL.Port() == "" {
+		proxyAddr = net.JoinHostPort(pd.proxyURL.Hostname(), "80")
+	}
+
+	conn, err := pd.dialer.DialContext(ctx, network, proxyAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = pd.connect(ctx, conn, address); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	return conn, nil
+}
+
+// connect sends a CONNECT request for address over conn and waits for the proxy to accept it.
+func (pd *proxyDialer) connect(ctx context.Context, conn net.Conn, address string) error {
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return err
+		}
+		defer func() {
+			_ = conn.SetDeadline(time.Time{})
+		}()
+	}
+
+	req := &http.Request{
+		Method: http.MethodConnect,
+		URL:    &url.URL{Opaque: address},
+		Host:   address,
+		Header: make(http.Header),
+	}
+	if user := pd.proxyURL.User; user != nil {
+		password, _ := user.Password()
+		credentials := base64.StdEncoding.EncodeToString([]byte(user.Username() + ":" + password))
+		req.Header.Set("Proxy-Authorization", "Basic "+credentials)
+	}
+	if err := req.Write(conn); err != nil {
+		return fmt.Errorf("unable to send CONNECT request to proxy %s: %v", pd.proxyURL.Host, err)
+	}
+
+	// MongoDB servers only send data in response to a request, so nothing should follow the proxy's response and the
+	// buffered reader can be discarded once the response has been parsed.
+	br := bufio.NewReaderSize(conn, 1024)
+	resp, err := http.ReadResponse(br, req)
+	if err != nil {
+		return fmt.Errorf("unable to read CONNECT response from proxy %s: %v", pd.proxyURL.Host, err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("proxy %s refused CONNECT to %s: %s", pd.proxyURL.Host, address, resp.Status)
+	}
+	if br.Buffered() > 0 {
+		return fmt.Errorf("proxy %s sent unexpected data after CONNECT response", pd.proxyURL.Host)
+	}
+	return nil
+}
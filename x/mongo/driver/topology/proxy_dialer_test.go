@@ -0,0 +1,101 @@
+// Copyright (C) MongoDB, Inc. 2017-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package topology
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/internal/testutil/assert"
+)
+
+// startTestProxy starts a proxy that accepts a single CONNECT request, replies with status, and then echoes any data
+// sent over the tunnel. The parsed request is sent on the returned channel. The caller must close the returned
+// listener.
+func startTestProxy(t *testing.T, status int) (net.Listener, <-chan *http.Request) {
+	t.Helper()
+
+	l, err := net.Listen("tcp", "localhost:0")
+	assert.Nil(t, err, "Listen error: %v", err)
+
+	requests := make(chan *http.Request, 1)
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		req, err := http.ReadRequest(bufio.NewReader(conn))
+		if err != nil {
+			return
+		}
+		requests <- req
+
+		resp := &http.Response{StatusCode: status, ProtoMajor: 1, ProtoMinor: 1}
+		if err = resp.Write(conn); err != nil || status != http.StatusOK {
+			return
+		}
+		_, _ = io.Copy(conn, conn)
+	}()
+	return l, requests
+}
+
+func TestProxyDialer(t *testing.T) {
+	t.Run("tunnels through proxy", func(t *testing.T) {
+		l, requests := startTestProxy(t, http.StatusOK)
+		defer l.Close()
+		proxyURL := &url.URL{Scheme: "http", Host: l.Addr().String(), User: url.UserPassword("user", "pwd")}
+		dialer := newProxyDialer(proxyURL, &net.Dialer{})
+
+		conn, err := dialer.DialContext(context.Background(), "tcp", "db.example.com:27017")
+		assert.Nil(t, err, "DialContext error: %v", err)
+		defer conn.Close()
+
+		req := <-requests
+		assert.Equal(t, http.MethodConnect, req.Method, "expected method %v, got %v", http.MethodConnect, req.Method)
+		assert.Equal(t, "db.example.com:27017", req.Host, "expected host %v, got %v", "db.example.com:27017", req.Host)
+		wantAuth := "Basic " + base64.StdEncoding.EncodeToString([]byte("user:pwd"))
+		gotAuth := req.Header.Get("Proxy-Authorization")
+		assert.Equal(t, wantAuth, gotAuth, "expected Proxy-Authorization %v, got %v", wantAuth, gotAuth)
+
+		_, err = conn.Write([]byte("ping"))
+		assert.Nil(t, err, "Write error: %v", err)
+		buf := make([]byte, 4)
+		_, err = io.ReadFull(conn, buf)
+		assert.Nil(t, err, "ReadFull error: %v", err)
+		assert.Equal(t, "ping", string(buf), "expected echoed data %q, got %q", "ping", string(buf))
+	})
+	t.Run("proxy refuses CONNECT", func(t *testing.T) {
+		l, _ := startTestProxy(t, http.StatusProxyAuthRequired)
+		defer l.Close()
+		dialer := newProxyDialer(&url.URL{Scheme: "http", Host: l.Addr().String()}, &net.Dialer{})
+
+		_, err := dialer.DialContext(context.Background(), "tcp", "db.example.com:27017")
+		assert.NotNil(t, err, "expected error, got nil")
+	})
+	t.Run("non-tcp networks are not proxied", func(t *testing.T) {
+		var gotNetwork, gotAddress string
+		inner := DialerFunc(func(_ context.Context, network, address string) (net.Conn, error) {
+			gotNetwork, gotAddress = network, address
+			return nil, nil
+		})
+		dialer := newProxyDialer(&url.URL{Scheme: "http", Host: "proxy.example.com"}, inner)
+
+		_, err := dialer.DialContext(context.Background(), "unix", "/tmp/mongodb-27017.sock")
+		assert.Nil(t, err, "DialContext error: %v", err)
+		assert.Equal(t, "unix", gotNetwork, "expected network %v, got %v", "unix", gotNetwork)
+		assert.Equal(t, "/tmp/mongodb-27017.sock", gotAddress, "expected address %v, got %v",
+			"/tmp/mongodb-27017.sock", gotAddress)
+	})
+}
@@ -251,6 +251,8 @@ func setUpTopology(t *testing.T, uri string) *Topology {
 		WithTopologyServerMonitor(func(*event.ServerMonitor) *event.ServerMonitor {
 			return sdam
 		}),
+		// The monitoring tests expect servers to be opened in seed list order.
+		WithSeedListShuffle(func(bool) bool { return false }),
 	)
 	assert.Nil(t, err, "topology.New error: %v", err)
 
@@ -174,7 +174,7 @@ func NewServer(addr address.Address, topologyID primitive.ObjectID, opts ...Serv
 	pc := poolConfig{
 		Address:     addr,
 		MinPoolSize: cfg.minConns,
-		MaxPoolSize: cfg.maxConns,
+		MaxPoolSize: cfg.poolCapacity(),
 		MaxIdleTime: cfg.connectionPoolMaxIdleTime,
 		PoolMonitor: cfg.poolMonitor,
 	}
@@ -184,6 +184,9 @@ func NewServer(addr address.Address, topologyID primitive.ObjectID, opts ...Serv
 	if err != nil {
 		return nil, err
 	}
+	if len(cfg.maxConnsByKind) > 0 {
+		s.pool.setMaxPoolSize(cfg.maxConnsForKind(description.Unknown))
+	}
 
 	s.publishServerOpeningEvent(s.address)
 
@@ -291,6 +294,16 @@ func (s *Server) Description() description.Server {
 	return s.desc.Load().(description.Server)
 }
 
+// PoolStats returns a snapshot of the connections in the server's connection pool.
+func (s *Server) PoolStats() PoolStats {
+	return s.pool.stats()
+}
+
+// ConnectionStats returns a snapshot of each open connection in the server's connection pool.
+func (s *Server) ConnectionStats() []ConnectionStats {
+	return s.pool.connectionStats()
+}
+
 // SelectedDescription returns a description.SelectedServer with a Kind of
 // Single. This can be used when performing tasks like monitoring a batch
 // of servers and you want to run one off commands against those servers.
@@ -548,8 +561,13 @@ func (s *Server) updateDescription(desc description.Server) {
 	if ok && callback != nil {
 		desc = callback(desc)
 	}
+	prev, _ := s.desc.Load().(description.Server)
 	s.desc.Store(desc)
 
+	if len(s.cfg.maxConnsByKind) > 0 && prev.Kind != desc.Kind {
+		s.pool.setMaxPoolSize(s.cfg.maxConnsForKind(desc.Kind))
+	}
+
 	s.subLock.Lock()
 	for _, c := range s.subscribers {
 		select {
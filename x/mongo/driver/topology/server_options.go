@@ -12,6 +12,7 @@ import (
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/bsoncodec"
 	"go.mongodb.org/mongo-driver/event"
+	"go.mongodb.org/mongo-driver/mongo/description"
 	"go.mongodb.org/mongo-driver/x/mongo/driver/session"
 )
 
@@ -25,6 +26,7 @@ type serverConfig struct {
 	heartbeatInterval         time.Duration
 	heartbeatTimeout          time.Duration
 	maxConns                  uint64
+	maxConnsByKind            map[description.ServerKind]uint64
 	minConns                  uint64
 	poolMonitor               *event.PoolMonitor
 	serverMonitor             *event.ServerMonitor
@@ -111,6 +113,18 @@ func WithMaxConnections(fn func(uint64) uint64) ServerOption {
 	}
 }
 
+// WithMaxConnectionsPerServerKind configures the maximum number of connections to allow for a server based on its
+// kind. The limit for a server is changed whenever its kind changes. Kinds that are not in the map use the limit
+// configured by WithMaxConnections. A limit of 0 means that the default will be math.MaxInt64.
+func WithMaxConnectionsPerServerKind(
+	fn func(map[description.ServerKind]uint64) map[description.ServerKind]uint64,
+) ServerOption {
+	return func(cfg *serverConfig) error {
+		cfg.maxConnsByKind = fn(cfg.maxConnsByKind)
+		return nil
+	}
+}
+
 // WithMinConnections configures the minimum number of connections to allow for
 // a given server. If min is 0, then there is no lower limit to the number of
 // connections.
@@ -163,3 +177,26 @@ func WithRegistry(fn func(*bsoncodec.Registry) *bsoncodec.Registry) ServerOption
 		return nil
 	}
 }
+
+// maxConnsForKind returns the maximum number of connections to allow for a server of the given kind.
+func (cfg *serverConfig) maxConnsForKind(kind description.ServerKind) uint64 {
+	if maxConns, ok := cfg.maxConnsByKind[kind]; ok {
+		return maxConns
+	}
+	return cfg.maxConns
+}
+
+// poolCapacity returns the largest number of connections that can be allowed for a server of any kind. If any limit
+// is 0, the capacity is unbounded and 0 is returned.
+func (cfg *serverConfig) poolCapacity() uint64 {
+	capacity := cfg.maxConns
+	for _, maxConns := range cfg.maxConnsByKind {
+		if capacity == 0 || maxConns == 0 {
+			return 0
+		}
+		if maxConns > capacity {
+			capacity = maxConns
+		}
+	}
+	return capacity
+}
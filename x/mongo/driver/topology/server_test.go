@@ -11,6 +11,7 @@ package topology
 import (
 	"context"
 	"errors"
+	"math"
 	"net"
 	"runtime"
 	"sync"
@@ -414,6 +415,48 @@ func TestServer(t *testing.T) {
 		assert.Equal(t, s.cfg.heartbeatTimeout, conn.readTimeout, "expected readTimeout to be: %v, got: %v", s.cfg.heartbeatTimeout, conn.readTimeout)
 		assert.Equal(t, s.cfg.heartbeatTimeout, conn.writeTimeout, "expected writeTimeout to be: %v, got: %v", s.cfg.heartbeatTimeout, conn.writeTimeout)
 	})
+	t.Run("WithMaxConnectionsPerServerKind", func(t *testing.T) {
+		sizes := map[description.ServerKind]uint64{description.RSPrimary: 100, description.RSSecondary: 10}
+		s, err := NewServer(
+			address.Address("localhost"),
+			primitive.NewObjectID(),
+			WithMaxConnections(func(uint64) uint64 { return 50 }),
+			WithMaxConnectionsPerServerKind(func(map[description.ServerKind]uint64) map[description.ServerKind]uint64 {
+				return sizes
+			}),
+		)
+		assert.Nil(t, err, "NewServer error: %v", err)
+
+		maxPoolSize := func() int64 { return s.pool.semSize - s.pool.reserved }
+		assert.Equal(t, int64(100), s.pool.semSize, "expected pool capacity %v, got %v", 100, s.pool.semSize)
+		assert.Equal(t, int64(50), maxPoolSize(), "expected max pool size %v, got %v", 50, maxPoolSize())
+
+		s.updateDescription(description.Server{Addr: s.address, Kind: description.RSSecondary})
+		assert.Equal(t, int64(10), maxPoolSize(), "expected max pool size %v, got %v", 10, maxPoolSize())
+		s.updateDescription(description.Server{Addr: s.address, Kind: description.RSPrimary})
+		assert.Equal(t, int64(100), maxPoolSize(), "expected max pool size %v, got %v", 100, maxPoolSize())
+	})
+	t.Run("WithMaxConnectionsPerServerKind unbounded kind", func(t *testing.T) {
+		sizes := map[description.ServerKind]uint64{description.RSPrimary: 0, description.RSSecondary: 10}
+		s, err := NewServer(
+			address.Address("localhost"),
+			primitive.NewObjectID(),
+			WithMaxConnectionsPerServerKind(func(map[description.ServerKind]uint64) map[description.ServerKind]uint64 {
+				return sizes
+			}),
+		)
+		assert.Nil(t, err, "NewServer error: %v", err)
+
+		maxPoolSize := func() int64 { return s.pool.semSize - s.pool.reserved }
+		assert.Equal(t, int64(math.MaxInt64), s.pool.semSize, "expected unbounded pool capacity, got %v",
+			s.pool.semSize)
+		assert.Equal(t, int64(100), maxPoolSize(), "expected max pool size %v, got %v", 100, maxPoolSize())
+
+		s.updateDescription(description.Server{Addr: s.address, Kind: description.RSSecondary})
+		assert.Equal(t, int64(10), maxPoolSize(), "expected max pool size %v, got %v", 10, maxPoolSize())
+		s.updateDescription(description.Server{Addr: s.address, Kind: description.RSPrimary})
+		assert.Equal(t, int64(0), s.pool.reserved, "expected no reserved connections, got %v", s.pool.reserved)
+	})
 }
 
 func includesMetadata(t *testing.T, wm []byte) bool {
@@ -25,6 +25,7 @@ import (
 	"go.mongodb.org/mongo-driver/event"
 	"go.mongodb.org/mongo-driver/mongo/address"
 	"go.mongodb.org/mongo-driver/mongo/description"
+	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
 	"go.mongodb.org/mongo-driver/x/mongo/driver"
 	"go.mongodb.org/mongo-driver/x/mongo/driver/dns"
 )
@@ -45,6 +46,10 @@ var ErrTopologyConnected = errors.New("topology is connected or connecting")
 // selection process took longer than allowed by the timeout.
 var ErrServerSelectionTimeout = errors.New("server selection timeout")
 
+// ErrNoSuitableServer is returned from server selection when fail fast is enabled and the settled topology does not
+// contain a server that matches the selector.
+var ErrNoSuitableServer = errors.New("no suitable server in settled topology")
+
 // MonitorMode represents the way in which a server is monitored.
 type MonitorMode uint8
 
@@ -62,6 +67,9 @@ type Topology struct {
 
 	desc atomic.Value // holds a description.Topology
 
+	kindsChangedAt    atomic.Value // holds a time.Time
+	heartbeatInterval time.Duration
+
 	dnsResolver *dns.Resolver
 
 	done chan struct{}
@@ -115,6 +123,10 @@ func New(opts ...Option) (*Topology, error) {
 	if err != nil {
 		return nil, err
 	}
+	serverCfg, err := newServerConfig(cfg.serverOpts...)
+	if err != nil {
+		return nil, err
+	}
 
 	t := &Topology{
 		cfg:               cfg,
@@ -126,6 +138,7 @@ func New(opts ...Option) (*Topology, error) {
 		servers:           make(map[address.Address]*Server),
 		dnsResolver:       dns.DefaultResolver,
 		id:                primitive.NewObjectID(),
+		heartbeatInterval: serverCfg.heartbeatInterval,
 	}
 	t.desc.Store(description.Topology{})
 	t.updateCallback = func(desc description.Server) description.Server {
@@ -176,8 +189,19 @@ func (t *Topology) Connect() error {
 		SessionTimeoutMinutes: t.fsm.SessionTimeoutMinutes,
 	}
 	t.desc.Store(newDesc)
+	t.kindsChangedAt.Store(time.Now())
 	t.publishTopologyDescriptionChangedEvent(description.Topology{}, t.fsm.Topology)
-	for _, a := range t.cfg.seedList {
+
+	// The servers start monitoring in the order they are added, so this is the order in which the seeds are opened.
+	seedList := t.cfg.seedList
+	if t.cfg.shuffleSeedList {
+		seedList = make([]string, len(t.cfg.seedList))
+		copy(seedList, t.cfg.seedList)
+		rand.Shuffle(len(seedList), func(i, j int) {
+			seedList[i], seedList[j] = seedList[j], seedList[i]
+		})
+	}
+	for _, a := range seedList {
 		addr := address.Address(a).Canonicalize()
 		err = t.addServer(addr)
 		if err != nil {
@@ -246,6 +270,48 @@ func (t *Topology) Description() description.Topology {
 	return td
 }
 
+// ValidateCommand implements the driver.CommandValidator interface. It runs the validator configured through the
+// WithCommandValidator option, if there is one.
+func (t *Topology) ValidateCommand(cmd bsoncore.Document) error {
+	if t.cfg.commandValidator == nil {
+		return nil
+	}
+	return t.cfg.commandValidator(cmd)
+}
+
+// TrackOperation implements the driver.OperationTracker interface. If no tracker was configured via
+// WithOperationTracker, the context is returned unchanged.
+func (t *Topology) TrackOperation(ctx context.Context) (context.Context, func()) {
+	if t.cfg.operationTracker == nil {
+		return ctx, func() {}
+	}
+	return t.cfg.operationTracker(ctx)
+}
+
+// PoolStats returns a snapshot of the connection pool of each server in the Topology, keyed by server address.
+func (t *Topology) PoolStats() map[address.Address]PoolStats {
+	t.serversLock.Lock()
+	defer t.serversLock.Unlock()
+
+	stats := make(map[address.Address]PoolStats, len(t.servers))
+	for addr, server := range t.servers {
+		stats[addr] = server.PoolStats()
+	}
+	return stats
+}
+
+// ConnectionStats returns a snapshot of each open connection in the connection pool of the server at addr. An error is
+// returned if the Topology does not know about a server at addr.
+func (t *Topology) ConnectionStats(addr address.Address) ([]ConnectionStats, error) {
+	t.serversLock.Lock()
+	server, ok := t.servers[addr.Canonicalize()]
+	t.serversLock.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("no server with address %s in topology", addr)
+	}
+	return server.ConnectionStats(), nil
+}
+
 // Kind returns the topology kind of this Topology.
 func (t *Topology) Kind() description.TopologyKind { return t.Description().Kind }
 
@@ -316,6 +382,21 @@ func (t *Topology) RequestImmediateCheck() {
 // server selection spec, and will time out after severSelectionTimeout or when the
 // parent context is done.
 func (t *Topology) SelectServer(ctx context.Context, ss description.ServerSelector) (driver.Server, error) {
+	selected, err := t.selectServer(ctx, ss)
+	if t.cfg.serverSelectionHook != nil {
+		var desc description.Server
+		if selected != nil {
+			desc = selected.Server.Description()
+		}
+		t.cfg.serverSelectionHook(desc, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return selected, nil
+}
+
+func (t *Topology) selectServer(ctx context.Context, ss description.ServerSelector) (*SelectedServer, error) {
 	if atomic.LoadInt32(&t.connectionstate) != connected {
 		return nil, ErrTopologyClosed
 	}
@@ -337,8 +418,12 @@ func (t *Topology) SelectServer(ctx context.Context, ss description.ServerSelect
 		if !doneOnce {
 			// for the first pass, select a server from the current description.
 			// this improves selection speed for up-to-date topology descriptions.
-			suitable, selectErr = t.selectServerFromDescription(t.Description(), selectionState)
+			desc := t.Description()
+			suitable, selectErr = t.selectServerFromDescription(desc, selectionState)
 			doneOnce = true
+			if selectErr == nil && len(suitable) == 0 && t.cfg.failFast && t.settled(desc) {
+				return nil, ServerSelectionError{Wrapped: ErrNoSuitableServer, Desc: desc}
+			}
 		} else {
 			// if the first pass didn't select a server, the previous description did not contain a suitable server, so
 			// we subscribe to the topology and attempt to obtain a server from that subscription
@@ -470,6 +555,48 @@ func (t *Topology) selectServerFromSubscription(ctx context.Context, subscriptio
 	}
 }
 
+// settled returns true if desc is not expected to change without a change in the state of the deployment. A topology
+// is settled once every server has completed at least one check and the kinds of the topology and its servers have not
+// changed for at least one heartbeat interval, so it is not settled during initial discovery. A replica set without a
+// primary is never settled while it has a secondary, because an election may be in progress, however long it takes.
+func (t *Topology) settled(desc description.Topology) bool {
+	if desc.Kind == description.Unknown || len(desc.Servers) == 0 {
+		return false
+	}
+	for _, s := range desc.Servers {
+		if s.Kind == description.Unknown && s.LastError == nil {
+			return false
+		}
+		if desc.Kind == description.ReplicaSetNoPrimary && s.Kind == description.RSSecondary {
+			return false
+		}
+	}
+
+	changedAt, ok := t.kindsChangedAt.Load().(time.Time)
+	if !ok {
+		return false
+	}
+	return time.Since(changedAt) >= t.heartbeatInterval
+}
+
+// serverKindsChanged returns true if the kind of the topology or the set of servers and their kinds differ between
+// prev and current. Changes to other fields, such as round trip times, are ignored.
+func serverKindsChanged(prev, current description.Topology) bool {
+	if prev.Kind != current.Kind || len(prev.Servers) != len(current.Servers) {
+		return true
+	}
+	kinds := make(map[address.Address]description.ServerKind, len(prev.Servers))
+	for _, s := range prev.Servers {
+		kinds[s.Addr] = s.Kind
+	}
+	for _, s := range current.Servers {
+		if kind, ok := kinds[s.Addr]; !ok || kind != s.Kind {
+			return true
+		}
+	}
+	return false
+}
+
 // selectServerFromDescription process the given topology description and returns a slice of suitable servers.
 func (t *Topology) selectServerFromDescription(desc description.Topology,
 	selectionState serverSelectionState) ([]description.Server, error) {
@@ -664,6 +791,9 @@ func (t *Topology) apply(ctx context.Context, desc description.Server) descripti
 	}
 
 	t.desc.Store(current)
+	if serverKindsChanged(prev, current) {
+		t.kindsChangedAt.Store(time.Now())
+	}
 	if !prev.Equal(current) {
 		t.publishTopologyDescriptionChangedEvent(prev, current)
 	}
@@ -16,6 +16,7 @@ import (
 	"time"
 
 	"go.mongodb.org/mongo-driver/internal/testutil/assert"
+	"go.mongodb.org/mongo-driver/mongo/address"
 	"go.mongodb.org/mongo-driver/mongo/description"
 )
 
@@ -59,4 +60,19 @@ func TestTopologyErrors(t *testing.T) {
 				context.DeadlineExceeded, serverSelectionErr)
 		})
 	})
+	t.Run("server selection error message", func(t *testing.T) {
+		desc := description.Topology{
+			Kind: description.ReplicaSetNoPrimary,
+			Servers: []description.Server{
+				{Addr: address.Address("one:27017"), Kind: description.Unknown, LastError: errors.New("connection refused")},
+				description.Server{Addr: address.Address("two:27017"), Kind: description.RSSecondary}.
+					SetAverageRTT(5 * time.Millisecond),
+			},
+		}
+		err := ServerSelectionError{Wrapped: ErrServerSelectionTimeout, Desc: desc}
+		want := "server selection error: server selection timeout, current topology: { Type: ReplicaSetNoPrimary, " +
+			"Servers: [{ Addr: one:27017, Type: Unknown, Average RTT: unknown, Last error: connection refused }, " +
+			"{ Addr: two:27017, Type: RSSecondary, Average RTT: 5ms }] }"
+		assert.Equal(t, want, err.Error(), "expected error message %q, got %q", want, err.Error())
+	})
 }
@@ -8,6 +8,7 @@ package topology
 
 import (
 	"bytes"
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/pem"
@@ -18,6 +19,8 @@ import (
 	"time"
 
 	"go.mongodb.org/mongo-driver/event"
+	"go.mongodb.org/mongo-driver/mongo/description"
+	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
 	"go.mongodb.org/mongo-driver/x/mongo/driver"
 	"go.mongodb.org/mongo-driver/x/mongo/driver/auth"
 	"go.mongodb.org/mongo-driver/x/mongo/driver/connstring"
@@ -31,16 +34,22 @@ type config struct {
 	mode                   MonitorMode
 	replicaSetName         string
 	seedList               []string
+	shuffleSeedList        bool
 	serverOpts             []ServerOption
 	cs                     connstring.ConnString // This must not be used for any logic in topology.Topology.
 	uri                    string
 	serverSelectionTimeout time.Duration
 	serverMonitor          *event.ServerMonitor
+	commandValidator       func(bsoncore.Document) error
+	serverSelectionHook    func(description.Server, error)
+	operationTracker       func(context.Context) (context.Context, func())
+	failFast               bool
 }
 
 func newConfig(opts ...Option) (*config, error) {
 	cfg := &config{
 		seedList:               []string{"localhost:27017"},
+		shuffleSeedList:        true,
 		serverSelectionTimeout: 30 * time.Second,
 	}
 
@@ -234,6 +243,46 @@ func WithConnString(fn func(connstring.ConnString) connstring.ConnString) Option
 	}
 }
 
+// WithCommandValidator configures a function that is run against every command sent through the topology. If the
+// function returns an error, the command is not sent. See the driver.CommandValidator documentation for more
+// information.
+func WithCommandValidator(fn func(func(bsoncore.Document) error) func(bsoncore.Document) error) Option {
+	return func(cfg *config) error {
+		cfg.commandValidator = fn(cfg.commandValidator)
+		return nil
+	}
+}
+
+// WithOperationTracker configures a function that is called at the start of every operation executed against the
+// topology. See the driver.OperationTracker documentation for more information.
+func WithOperationTracker(fn func(func(context.Context) (context.Context, func())) func(context.Context) (context.Context, func())) Option {
+	return func(cfg *config) error {
+		cfg.operationTracker = fn(cfg.operationTracker)
+		return nil
+	}
+}
+
+// WithServerSelectionHook configures a function that is called with the outcome of every call to SelectServer. The
+// function is called with the description of the selected server if selection succeeds, or the selection error if it
+// fails.
+func WithServerSelectionHook(fn func(func(description.Server, error)) func(description.Server, error)) Option {
+	return func(cfg *config) error {
+		cfg.serverSelectionHook = fn(cfg.serverSelectionHook)
+		return nil
+	}
+}
+
+// WithFailFast configures whether server selection returns ErrNoSuitableServer immediately when the topology is settled
+// and contains no server matching the selector, instead of waiting for the server selection timeout. Selection still
+// waits while servers are being discovered or the topology is changing, and while a replica set without a primary has a
+// secondary that could be elected. The default is false.
+func WithFailFast(fn func(bool) bool) Option {
+	return func(cfg *config) error {
+		cfg.failFast = fn(cfg.failFast)
+		return nil
+	}
+}
+
 // WithMode configures the topology's monitor mode.
 func WithMode(fn func(MonitorMode) MonitorMode) Option {
 	return func(cfg *config) error {
@@ -258,6 +307,16 @@ func WithSeedList(fn func(...string) []string) Option {
 	}
 }
 
+// WithSeedListShuffle configures whether the servers in the seed list are opened in a random order. If false, servers
+// are opened in the order they appear in the seed list. The order of the servers in the initial topology description
+// is not affected. The default is true.
+func WithSeedListShuffle(fn func(bool) bool) Option {
+	return func(cfg *config) error {
+		cfg.shuffleSeedList = fn(cfg.shuffleSeedList)
+		return nil
+	}
+}
+
 // WithServerOptions configures a topology's server options for when a new server
 // needs to be created.
 func WithServerOptions(fn func(...ServerOption) []ServerOption) Option {
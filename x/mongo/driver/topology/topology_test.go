@@ -14,6 +14,7 @@ import (
 	"testing"
 	"time"
 
+	"go.mongodb.org/mongo-driver/event"
 	"go.mongodb.org/mongo-driver/internal/testutil/assert"
 	"go.mongodb.org/mongo-driver/mongo/address"
 	"go.mongodb.org/mongo-driver/mongo/description"
@@ -408,6 +409,46 @@ func TestServerSelection(t *testing.T) {
 		_, err = topo.SelectServer(context.Background(), description.WriteSelector())
 		assert.Equal(t, ErrSubscribeAfterClosed, err, "expected error %v, got %v", ErrSubscribeAfterClosed, err)
 	})
+	t.Run("fail fast", func(t *testing.T) {
+		secondary := description.Server{Addr: "one", Kind: description.RSSecondary}
+		arbiter := description.Server{Addr: "one", Kind: description.RSArbiter}
+		unchecked := description.Server{Addr: "two", Kind: description.Unknown}
+		failed := description.Server{Addr: "two", Kind: description.Unknown, LastError: errors.New("connection refused")}
+
+		testCases := []struct {
+			name      string
+			servers   []description.Server
+			changedAt time.Time
+			settled   bool
+		}{
+			{"settled", []description.Server{arbiter, failed}, time.Now().Add(-time.Minute), true},
+			{"server not checked", []description.Server{arbiter, unchecked}, time.Now().Add(-time.Minute), false},
+			{"recent change", []description.Server{arbiter, failed}, time.Now(), false},
+			{"secondary can be elected", []description.Server{secondary, failed}, time.Now().Add(-time.Minute), false},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				topo, err := New(WithFailFast(func(bool) bool { return true }))
+				noerr(t, err)
+				atomic.StoreInt32(&topo.connectionstate, connected)
+				desc := description.Topology{Kind: description.ReplicaSetNoPrimary, Servers: tc.servers}
+				topo.desc.Store(desc)
+				topo.kindsChangedAt.Store(tc.changedAt)
+
+				// Close subscriptions so selection errors immediately if it does not fail fast.
+				topo.subscriptionsClosed = true
+				_, err = topo.SelectServer(context.Background(), description.WriteSelector())
+				if !tc.settled {
+					assert.Equal(t, ErrSubscribeAfterClosed, err, "expected error %v, got %v", ErrSubscribeAfterClosed, err)
+					return
+				}
+				sse, ok := err.(ServerSelectionError)
+				assert.True(t, ok, "expected error type %T, got %T", ServerSelectionError{}, err)
+				assert.Equal(t, ErrNoSuitableServer, sse.Wrapped, "expected wrapped error %v, got %v",
+					ErrNoSuitableServer, sse.Wrapped)
+			})
+		}
+	})
 }
 
 func TestSessionTimeout(t *testing.T) {
@@ -580,6 +621,33 @@ func TestMinPoolSize(t *testing.T) {
 	}
 }
 
+func TestSeedListOrder(t *testing.T) {
+	seeds := []string{"a:27017", "b:27017", "c:27017", "d:27017", "e:27017"}
+	var opened []string
+	monitor := &event.ServerMonitor{
+		ServerOpening: func(evt *event.ServerOpeningEvent) {
+			opened = append(opened, evt.Address.String())
+		},
+	}
+	topo, err := New(
+		WithSeedList(func(...string) []string { return seeds }),
+		WithSeedListShuffle(func(bool) bool { return false }),
+		WithServerOptions(func(opts ...ServerOption) []ServerOption {
+			return append(opts, WithServerMonitor(func(*event.ServerMonitor) *event.ServerMonitor { return monitor }))
+		}),
+	)
+	assert.Nil(t, err, "topology.New error: %v", err)
+	err = topo.Connect()
+	assert.Nil(t, err, "topology.Connect error: %v", err)
+	defer func() { _ = topo.Disconnect(context.Background()) }()
+
+	assert.Equal(t, seeds, opened, "expected servers to be opened in order %v, got %v", seeds, opened)
+	desc := topo.Description()
+	for i, s := range desc.Servers {
+		assert.Equal(t, seeds[i], s.Addr.String(), "expected server %v to be %v, got %v", i, seeds[i], s.Addr)
+	}
+}
+
 func TestTopology_String_Race(t *testing.T) {
 	ch := make(chan bool)
 	topo := &Topology{